@@ -24,11 +24,21 @@ import (
 )
 
 const (
-	sdkName              = "sds-go-sdk"
-	headerNameUserAgent  = "User-Agent"
-	blockApiVersion      = "2025-02-01" // Volume and host operations
-	objectApiVersion     = "2025-02-01" // Cred and cert operations
-	headerNameAPIVersion = "IBM-API-Version"
+	sdkName             = "sds-go-sdk"
+	headerNameUserAgent = "User-Agent"
+	blockApiVersion     = "2025-02-01" // Volume, host, mapping and snapshot operations
+	objectApiVersion    = "2025-02-01" // Cred and cert operations
+)
+
+const (
+	// HeaderNameAPIVersion is the header that selects the version of the API used for a request.
+	HeaderNameAPIVersion = "IBM-API-Version"
+
+	// ServiceFamilyBlock identifies the volume, host, volume mapping and snapshot operations.
+	ServiceFamilyBlock = "block"
+
+	// ServiceFamilyObject identifies the HMAC credential and certificate operations.
+	ServiceFamilyObject = "object"
 )
 
 // GetSdkHeaders - returns the set of SDK-specific headers to be included in an outgoing request.
@@ -71,14 +81,28 @@ func GetSdkHeaders(serviceName string, serviceVersion string, operationId string
 
 	sdkHeaders[headerNameUserAgent] = GetUserAgentInfo()
 
+	sdkHeaders[HeaderNameAPIVersion] = GetDefaultAPIVersion(operationId)
+
+	return sdkHeaders
+}
+
+// GetServiceFamily returns the service family (ServiceFamilyBlock or ServiceFamilyObject) of an operation.
+func GetServiceFamily(operationId string) string {
 	switch operationId {
-	case "Creds", "CredCreate", "CredDelete", "Cert", "CertUpload":
-		sdkHeaders[headerNameAPIVersion] = objectApiVersion
+	case "ListHmacCredentials", "CreateHmacCredentials", "DeleteHmacCredentials",
+		"ListCertificates", "GetS3SslCertStatus", "CreateSslCert", "ReplaceSslCert", "DeleteSslCert":
+		return ServiceFamilyObject
 	default:
-		sdkHeaders[headerNameAPIVersion] = blockApiVersion
+		return ServiceFamilyBlock
 	}
+}
 
-	return sdkHeaders
+// GetDefaultAPIVersion returns the API version sent for an operation unless the client pins another one.
+func GetDefaultAPIVersion(operationId string) string {
+	if GetServiceFamily(operationId) == ServiceFamilyObject {
+		return objectApiVersion
+	}
+	return blockApiVersion
 }
 
 var userAgent string = fmt.Sprintf("%s/%s %s", sdkName, Version, GetSystemInfo())
@@ -42,3 +42,15 @@ func TestGetSdkHeaders(t *testing.T) {
 	assert.True(t, foundIt)
 	t.Logf("user agent: %s\n", headers[headerNameUserAgent])
 }
+
+func TestGetServiceFamily(t *testing.T) {
+	for _, operationId := range []string{"ListHmacCredentials", "CreateHmacCredentials", "DeleteHmacCredentials",
+		"ListCertificates", "GetS3SslCertStatus", "CreateSslCert", "ReplaceSslCert", "DeleteSslCert"} {
+		assert.Equal(t, ServiceFamilyObject, GetServiceFamily(operationId), operationId)
+		assert.Equal(t, objectApiVersion, GetSdkHeaders("sdsaas", "V2", operationId)[HeaderNameAPIVersion])
+	}
+	for _, operationId := range []string{"ListVolumes", "CreateHost", "CreateVolumeMapping", "DeleteSnapshots"} {
+		assert.Equal(t, ServiceFamilyBlock, GetServiceFamily(operationId), operationId)
+		assert.Equal(t, blockApiVersion, GetSdkHeaders("sdsaas", "V2", operationId)[HeaderNameAPIVersion])
+	}
+}
@@ -0,0 +1,92 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package s3signer
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"strconv"
+	"time"
+)
+
+const chunkSignatureLength = 64
+
+// chunkedReader encodes a request body as signed aws-chunked chunks:
+//
+//	<hex size>;chunk-signature=<signature>\r\n<data>\r\n
+//
+// followed by a final zero-length chunk.
+type chunkedReader struct {
+	body          io.ReadCloser
+	signer        *Signer
+	now           time.Time
+	chunkSize     int
+	lastSignature string
+	buffer        bytes.Buffer
+	done          bool
+}
+
+func (reader *chunkedReader) Read(p []byte) (n int, err error) {
+	for reader.buffer.Len() == 0 {
+		if reader.done {
+			return 0, io.EOF
+		}
+		err = reader.nextChunk()
+		if err != nil {
+			return 0, err
+		}
+	}
+	return reader.buffer.Read(p)
+}
+
+func (reader *chunkedReader) Close() error {
+	return reader.body.Close()
+}
+
+func (reader *chunkedReader) nextChunk() error {
+	chunk := make([]byte, reader.chunkSize)
+	n, err := io.ReadFull(reader.body, chunk)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return err
+	}
+	chunk = chunk[:n]
+
+	signature := reader.signer.chunkSignature(reader.now, reader.lastSignature, chunk)
+	reader.lastSignature = signature
+	reader.buffer.WriteString(strconv.FormatInt(int64(n), 16) + ";chunk-signature=" + signature + "\r\n")
+	reader.buffer.Write(chunk)
+	reader.buffer.WriteString("\r\n")
+
+	// The zero-length chunk that ends the body has just been written.
+	reader.done = n == 0
+	return nil
+}
+
+// chunkedContentLength returns the length of a body of decodedLength bytes once encoded as aws-chunked chunks.
+func chunkedContentLength(decodedLength int64, chunkSize int64) int64 {
+	length := int64(0)
+	for remaining := decodedLength; remaining > 0; remaining -= chunkSize {
+		length += chunkLength(min(remaining, chunkSize))
+	}
+	return length + chunkLength(0)
+}
+
+func chunkLength(size int64) int64 {
+	header := len(strconv.FormatInt(size, 16)) + len(";chunk-signature=") + chunkSignatureLength + len("\r\n")
+	return int64(header) + size + int64(len("\r\n"))
+}
@@ -0,0 +1,466 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package s3signer signs S3 requests and presigned URLs with AWS Signature Version 4, using the HMAC credentials
+// created by the sdsaasv2 package.
+package s3signer
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+)
+
+const (
+	// Algorithm is the signing algorithm of AWS Signature Version 4.
+	Algorithm = "AWS4-HMAC-SHA256"
+
+	// UnsignedPayload is the payload hash used when the request body is not signed.
+	UnsignedPayload = "UNSIGNED-PAYLOAD"
+
+	// StreamingPayload is the payload hash used when the body is sent as signed aws-chunked chunks.
+	StreamingPayload = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+
+	// DefaultRegion is the region used when Signer.Region is not set. Ceph accepts any region by default.
+	DefaultRegion = "us-east-1"
+
+	// DefaultService is the service used when Signer.Service is not set.
+	DefaultService = "s3"
+
+	// DefaultChunkSize is the chunk size used by SignChunkedRequest when none is given.
+	DefaultChunkSize = 64 * 1024
+
+	// MaxPresignExpires is the longest lifetime of a presigned URL that S3 accepts.
+	MaxPresignExpires = 7 * 24 * time.Hour
+
+	timeFormat     = "20060102T150405Z"
+	dateFormat     = "20060102"
+	chunkAlgorithm = "AWS4-HMAC-SHA256-PAYLOAD"
+	emptyHash      = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	minChunkSize   = 8 * 1024
+)
+
+// Headers that are never signed: they are commonly changed by proxies or by the HTTP client itself.
+var ignoredHeaders = map[string]bool{
+	"authorization":   true,
+	"connection":      true,
+	"expect":          true,
+	"user-agent":      true,
+	"x-amzn-trace-id": true,
+}
+
+// Credentials : An HMAC access key and secret key.
+type Credentials struct {
+	AccessKey string
+
+	SecretKey string
+
+	// An optional session token, sent as X-Amz-Security-Token.
+	SessionToken string
+}
+
+// NewCredentials : Instantiate Credentials from an access/secret key pair.
+func NewCredentials(accessKey string, secretKey string) Credentials {
+	return Credentials{AccessKey: accessKey, SecretKey: secretKey}
+}
+
+// CredentialsFromAccessKey : Instantiate Credentials from the result of CreateHmacCredentials.
+func CredentialsFromAccessKey(accessKey *sdsaasv2.AccessKeyResponse) (credentials Credentials, err error) {
+	if accessKey == nil || accessKey.AccessKey == nil || accessKey.SecretKey == nil {
+		err = core.SDKErrorf(nil, "the access key response must include both the access key and the secret key", "missing-credentials", common.GetComponentInfo())
+		return
+	}
+	credentials = NewCredentials(*accessKey.AccessKey, *accessKey.SecretKey)
+	return
+}
+
+// Signer : Signs requests and presigns URLs for one S3 endpoint.
+type Signer struct {
+	Credentials Credentials
+
+	// The S3 endpoint, e.g. https://s3.example.com.
+	Endpoint *url.URL
+
+	// The signing region. Defaults to DefaultRegion.
+	Region string
+
+	// The signing service. Defaults to DefaultService.
+	Service string
+
+	// Address buckets as <bucket>.<endpoint host> instead of <endpoint>/<bucket>. Ceph endpoints usually only support
+	// the default path-style addressing.
+	VirtualHostedStyle bool
+
+	// Returns the signing time. Defaults to time.Now.
+	Now func() time.Time
+}
+
+// NewSigner : Instantiate Signer for the S3 endpoint.
+func NewSigner(credentials Credentials, endpoint string) (signer *Signer, err error) {
+	if credentials.AccessKey == "" || credentials.SecretKey == "" {
+		err = core.SDKErrorf(nil, "the access key and the secret key are required", "missing-credentials", common.GetComponentInfo())
+		return
+	}
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil || endpointURL.Scheme == "" || endpointURL.Host == "" {
+		err = core.SDKErrorf(err, "the S3 endpoint must be an absolute URL", "invalid-endpoint", common.GetComponentInfo())
+		return
+	}
+	signer = &Signer{
+		Credentials: credentials,
+		Endpoint:    endpointURL,
+	}
+	return
+}
+
+// SetRegion : Allow user to set Region
+func (signer *Signer) SetRegion(region string) *Signer {
+	signer.Region = region
+	return signer
+}
+
+// ObjectURL returns the unsigned URL of an object, or of the bucket when key is empty.
+func (signer *Signer) ObjectURL(bucket string, key string) *url.URL {
+	objectURL := *signer.Endpoint
+	objectURL.RawQuery = ""
+	path := strings.TrimSuffix(objectURL.Path, "/")
+	if signer.VirtualHostedStyle && bucket != "" {
+		objectURL.Host = bucket + "." + objectURL.Host
+	} else if bucket != "" {
+		path += "/" + bucket
+	}
+	objectURL.Path = path + "/" + key
+	objectURL.RawPath = ""
+	return &objectURL
+}
+
+// PresignGetObject returns a URL that downloads the object until it expires.
+func (signer *Signer) PresignGetObject(bucket string, key string, expires time.Duration) (string, error) {
+	return signer.Presign(http.MethodGet, signer.ObjectURL(bucket, key), expires, nil)
+}
+
+// PresignPutObject returns a URL that uploads the object until it expires.
+func (signer *Signer) PresignPutObject(bucket string, key string, expires time.Duration) (string, error) {
+	return signer.Presign(http.MethodPut, signer.ObjectURL(bucket, key), expires, nil)
+}
+
+// Presign returns a presigned URL for the method and URL. The given headers are signed along with the host, so the
+// request made with the URL must send them with the same values. The payload is always unsigned.
+func (signer *Signer) Presign(method string, target *url.URL, expires time.Duration, headers http.Header) (presigned string, err error) {
+	if expires <= 0 || expires > MaxPresignExpires {
+		err = core.SDKErrorf(nil, fmt.Sprintf("presigned URLs must expire within %s", MaxPresignExpires), "invalid-expires", common.GetComponentInfo())
+		return
+	}
+	now := signer.now()
+	scope := signer.scope(now)
+
+	header := headers.Clone()
+	if header == nil {
+		header = http.Header{}
+	}
+	header.Set("Host", target.Host)
+	signedHeaders, canonicalHeaders := canonicalizeHeaders(header)
+
+	query := target.Query()
+	query.Set("X-Amz-Algorithm", Algorithm)
+	query.Set("X-Amz-Credential", signer.Credentials.AccessKey+"/"+scope)
+	query.Set("X-Amz-Date", now.Format(timeFormat))
+	query.Set("X-Amz-Expires", strconv.FormatInt(int64(expires/time.Second), 10))
+	query.Set("X-Amz-SignedHeaders", signedHeaders)
+	if signer.Credentials.SessionToken != "" {
+		query.Set("X-Amz-Security-Token", signer.Credentials.SessionToken)
+	}
+	canonicalQuery := canonicalizeQuery(query)
+
+	canonicalRequest := strings.Join([]string{
+		method,
+		canonicalizePath(target),
+		canonicalQuery,
+		canonicalHeaders,
+		signedHeaders,
+		UnsignedPayload,
+	}, "\n")
+	signature := signer.signature(now, canonicalRequest)
+
+	presignedURL := *target
+	presignedURL.RawQuery = canonicalQuery + "&X-Amz-Signature=" + signature
+	presigned = presignedURL.String()
+	return
+}
+
+// SignRequest signs the request in place by setting its X-Amz-Date, X-Amz-Content-Sha256 and Authorization headers.
+// The payload hash may be UnsignedPayload, the hex SHA-256 of the body (see PayloadHash), or empty to hash the body
+// of the request, which is then buffered if it cannot be read again through req.GetBody.
+func (signer *Signer) SignRequest(req *http.Request, payloadHash string) (err error) {
+	if payloadHash == "" {
+		payloadHash, err = hashRequestBody(req)
+		if err != nil {
+			return
+		}
+	}
+	signer.sign(req, payloadHash, signer.now())
+	return
+}
+
+// SignChunkedRequest signs the request for a streaming upload: the body is sent as aws-chunked chunks of chunkSize
+// bytes (DefaultChunkSize when 0), each signed with the signature of the previous one. req.ContentLength must be the
+// length of the unencoded body; it is replaced by the length of the encoded body.
+func (signer *Signer) SignChunkedRequest(req *http.Request, chunkSize int) (err error) {
+	if chunkSize == 0 {
+		chunkSize = DefaultChunkSize
+	}
+	if chunkSize < minChunkSize {
+		err = core.SDKErrorf(nil, fmt.Sprintf("the chunk size must be at least %d bytes", minChunkSize), "invalid-chunk-size", common.GetComponentInfo())
+		return
+	}
+	if req.ContentLength < 0 || (req.ContentLength > 0 && req.Body == nil) {
+		err = core.SDKErrorf(nil, "chunked requests need a body of known length", "unknown-content-length", common.GetComponentInfo())
+		return
+	}
+
+	decodedLength := req.ContentLength
+	req.ContentLength = chunkedContentLength(decodedLength, int64(chunkSize))
+	req.Header.Set("Content-Encoding", "aws-chunked")
+	req.Header.Set("Content-Length", strconv.FormatInt(req.ContentLength, 10))
+	req.Header.Set("X-Amz-Decoded-Content-Length", strconv.FormatInt(decodedLength, 10))
+
+	now := signer.now()
+	seedSignature := signer.sign(req, StreamingPayload, now)
+
+	body := req.Body
+	if body == nil {
+		body = io.NopCloser(bytes.NewReader(nil))
+	}
+	req.Body = &chunkedReader{
+		body:          body,
+		signer:        signer,
+		now:           now,
+		chunkSize:     chunkSize,
+		lastSignature: seedSignature,
+	}
+	req.GetBody = nil
+	return
+}
+
+// PayloadHash returns the hex SHA-256 of a request body, for use with SignRequest.
+func PayloadHash(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+func (signer *Signer) sign(req *http.Request, payloadHash string, now time.Time) (signature string) {
+	req.Header.Del("Authorization")
+	req.Header.Set("X-Amz-Date", now.Format(timeFormat))
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+	if signer.Credentials.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", signer.Credentials.SessionToken)
+	}
+
+	header := req.Header.Clone()
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+	header.Set("Host", host)
+	signedHeaders, canonicalHeaders := canonicalizeHeaders(header)
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		canonicalizePath(req.URL),
+		canonicalizeQuery(req.URL.Query()),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	signature = signer.signature(now, canonicalRequest)
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		Algorithm, signer.Credentials.AccessKey, signer.scope(now), signedHeaders, signature))
+	return
+}
+
+func (signer *Signer) signature(now time.Time, canonicalRequest string) string {
+	stringToSign := strings.Join([]string{
+		Algorithm,
+		now.Format(timeFormat),
+		signer.scope(now),
+		hashString(canonicalRequest),
+	}, "\n")
+	return hex.EncodeToString(hmacSHA256(signer.signingKey(now), stringToSign))
+}
+
+func (signer *Signer) chunkSignature(now time.Time, previousSignature string, chunk []byte) string {
+	stringToSign := strings.Join([]string{
+		chunkAlgorithm,
+		now.Format(timeFormat),
+		signer.scope(now),
+		previousSignature,
+		emptyHash,
+		PayloadHash(chunk),
+	}, "\n")
+	return hex.EncodeToString(hmacSHA256(signer.signingKey(now), stringToSign))
+}
+
+func (signer *Signer) signingKey(now time.Time) []byte {
+	key := hmacSHA256([]byte("AWS4"+signer.Credentials.SecretKey), now.Format(dateFormat))
+	key = hmacSHA256(key, signer.region())
+	key = hmacSHA256(key, signer.service())
+	return hmacSHA256(key, "aws4_request")
+}
+
+func (signer *Signer) scope(now time.Time) string {
+	return strings.Join([]string{now.Format(dateFormat), signer.region(), signer.service(), "aws4_request"}, "/")
+}
+
+func (signer *Signer) region() string {
+	if signer.Region == "" {
+		return DefaultRegion
+	}
+	return signer.Region
+}
+
+func (signer *Signer) service() string {
+	if signer.Service == "" {
+		return DefaultService
+	}
+	return signer.Service
+}
+
+func (signer *Signer) now() time.Time {
+	if signer.Now != nil {
+		return signer.Now().UTC()
+	}
+	return time.Now().UTC()
+}
+
+// canonicalizePath encodes each segment of the path once. S3 does not normalize paths, so "." and ".." segments and
+// repeated slashes are kept.
+func canonicalizePath(target *url.URL) string {
+	path := target.Path
+	if path == "" {
+		return "/"
+	}
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = uriEncode(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+func canonicalizeQuery(query url.Values) string {
+	pairs := make([]string, 0, len(query))
+	for key, values := range query {
+		for _, value := range values {
+			pairs = append(pairs, uriEncode(key)+"="+uriEncode(value))
+		}
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "&")
+}
+
+func canonicalizeHeaders(header http.Header) (signedHeaders string, canonicalHeaders string) {
+	names := make([]string, 0, len(header))
+	values := map[string][]string{}
+	for name, headerValues := range header {
+		name = strings.ToLower(name)
+		if ignoredHeaders[name] {
+			continue
+		}
+		if _, ok := values[name]; !ok {
+			names = append(names, name)
+		}
+		for _, value := range headerValues {
+			values[name] = append(values[name], strings.Join(strings.Fields(value), " "))
+		}
+	}
+	sort.Strings(names)
+
+	var builder strings.Builder
+	for _, name := range names {
+		builder.WriteString(name + ":" + strings.Join(values[name], ",") + "\n")
+	}
+	return strings.Join(names, ";"), builder.String()
+}
+
+// uriEncode percent-encodes everything except the unreserved characters of RFC 3986.
+func uriEncode(value string) string {
+	var builder strings.Builder
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' {
+			builder.WriteByte(c)
+		} else {
+			fmt.Fprintf(&builder, "%%%02X", c)
+		}
+	}
+	return builder.String()
+}
+
+func hashRequestBody(req *http.Request) (hash string, err error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return emptyHash, nil
+	}
+	var body io.ReadCloser
+	if req.GetBody != nil {
+		body, err = req.GetBody()
+	} else {
+		var data []byte
+		data, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		req.Body = io.NopCloser(bytes.NewReader(data))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(data)), nil
+		}
+		body = io.NopCloser(bytes.NewReader(data))
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-body-error", common.GetComponentInfo())
+		return
+	}
+	defer body.Close()
+	digest := sha256.New()
+	_, err = io.Copy(digest, body)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-body-error", common.GetComponentInfo())
+		return
+	}
+	hash = hex.EncodeToString(digest.Sum(nil))
+	return
+}
+
+func hashString(value string) string {
+	return PayloadHash([]byte(value))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
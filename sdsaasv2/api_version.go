@@ -0,0 +1,126 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"maps"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+)
+
+// Service families that API versions can be pinned for in SdsaasV2Options.APIVersions.
+const (
+	ServiceFamilyBlock  = common.ServiceFamilyBlock
+	ServiceFamilyObject = common.ServiceFamilyObject
+)
+
+// Response headers describing the API version that served a request.
+const (
+	HeaderNameAPIVersion  = common.HeaderNameAPIVersion
+	HeaderNameDeprecation = "Deprecation"
+	HeaderNameSunset      = "Sunset"
+	HeaderNameLink        = "Link"
+)
+
+// SetAPIVersion pins the API version sent for an operation ID (e.g. "CreateSslCert") or for a service family
+// (ServiceFamilyBlock or ServiceFamilyObject). An empty version removes the pin.
+//
+// Like SetServiceURL, it configures the service before use: the pins are read by every operation without
+// synchronization, so it must not be called while operations of the service are in flight. To send other versions
+// from a service in use, pin them on a Clone.
+func (sdsaas *SdsaasV2) SetAPIVersion(operationOrFamily string, version string) {
+	// The map is replaced rather than updated, since clones of this service share it.
+	apiVersions := maps.Clone(sdsaas.apiVersions)
+	if apiVersions == nil {
+		apiVersions = map[string]string{}
+	}
+	if version == "" {
+		delete(apiVersions, operationOrFamily)
+	} else {
+		apiVersions[operationOrFamily] = version
+	}
+	sdsaas.apiVersions = apiVersions
+}
+
+// GetAPIVersion returns the API version sent for an operation ID: its own pin, else its family's pin, else the SDK
+// default.
+func (sdsaas *SdsaasV2) GetAPIVersion(operationId string) string {
+	if version, ok := sdsaas.apiVersions[operationId]; ok {
+		return version
+	}
+	if version, ok := sdsaas.apiVersions[common.GetServiceFamily(operationId)]; ok {
+		return version
+	}
+	return common.GetDefaultAPIVersion(operationId)
+}
+
+// getSdkHeaders returns the SDK headers of an operation, with its pinned API version.
+func (sdsaas *SdsaasV2) getSdkHeaders(operationId string) map[string]string {
+	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", operationId)
+	sdkHeaders[common.HeaderNameAPIVersion] = sdsaas.GetAPIVersion(operationId)
+	return sdkHeaders
+}
+
+// APIVersionInfo : The API version that served a request, and whether it is deprecated.
+type APIVersionInfo struct {
+	// The API version the server used, from the IBM-API-Version response header. Empty if the server did not say.
+	Version string
+
+	// Whether the server reported the API version or the operation as deprecated.
+	Deprecated bool
+
+	// When the API version or the operation was or will be deprecated, if the server gave a date.
+	DeprecationDate *time.Time
+
+	// When the API version or the operation will stop working, from the Sunset header.
+	SunsetDate *time.Time
+
+	// The Link header, which may point to the deprecation policy or to migration documentation.
+	Link string
+}
+
+// GetAPIVersionInfo reads the API version, deprecation and sunset headers of a response.
+func GetAPIVersionInfo(response *core.DetailedResponse) *APIVersionInfo {
+	if response == nil {
+		return nil
+	}
+	header := response.GetHeaders()
+	info := &APIVersionInfo{
+		Version: header.Get(HeaderNameAPIVersion),
+		Link:    header.Get(HeaderNameLink),
+	}
+
+	if deprecation := strings.TrimSpace(header.Get(HeaderNameDeprecation)); deprecation != "" {
+		// RFC 9745 sends "@<unix time>"; earlier drafts sent "true" or an HTTP date.
+		info.Deprecated = deprecation != "false"
+		if seconds, err := strconv.ParseInt(strings.TrimPrefix(deprecation, "@"), 10, 64); err == nil && strings.HasPrefix(deprecation, "@") {
+			date := time.Unix(seconds, 0).UTC()
+			info.DeprecationDate = &date
+		} else if date, err := http.ParseTime(deprecation); err == nil {
+			info.DeprecationDate = &date
+		}
+	}
+	if sunset, err := http.ParseTime(header.Get(HeaderNameSunset)); err == nil {
+		info.SunsetDate = &sunset
+	}
+	return info
+}
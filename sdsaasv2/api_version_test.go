@@ -0,0 +1,120 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`API version pinning`, func() {
+	var (
+		testServer *httptest.Server
+		versions   map[string]string
+	)
+	BeforeEach(func() {
+		versions = map[string]string{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			versions[req.URL.Path] = req.Header.Get("IBM-API-Version")
+			res.Header().Set("Content-type", "application/json")
+			res.Header().Set("IBM-API-Version", "2025-02-01")
+			if req.URL.Path == "/s3_credentials" {
+				res.Header().Set("Deprecation", "@1767225600")
+				res.Header().Set("Sunset", "Wed, 01 Jul 2026 00:00:00 GMT")
+				res.Header().Set("Link", `<https://cloud.ibm.com/docs/cephaas>; rel="deprecation"`)
+			}
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{}`)
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newService := func(apiVersions map[string]string) *sdsaasv2.SdsaasV2 {
+		sdsaasService, err := sdsaasv2.NewSdsaasV2(&sdsaasv2.SdsaasV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+			APIVersions:   apiVersions,
+		})
+		Expect(err).To(BeNil())
+		return sdsaasService
+	}
+
+	It(`Sends the default version of each service family`, func() {
+		sdsaasService := newService(nil)
+		Expect(sdsaasService.GetAPIVersion("ListHmacCredentials")).To(Equal("2025-02-01"))
+		Expect(sdsaasService.GetAPIVersion("ListVolumes")).To(Equal("2025-02-01"))
+
+		_, _, err := sdsaasService.ListHmacCredentials(sdsaasService.NewListHmacCredentialsOptions())
+		Expect(err).To(BeNil())
+		Expect(versions["/s3_credentials"]).To(Equal("2025-02-01"))
+	})
+	It(`Sends pinned versions by operation and by family`, func() {
+		sdsaasService := newService(map[string]string{
+			sdsaasv2.ServiceFamilyObject: "2026-01-01",
+			"ListCertificates":           "2026-03-01",
+		})
+		sdsaasService.SetAPIVersion(sdsaasv2.ServiceFamilyBlock, "2026-02-01")
+
+		_, _, err := sdsaasService.ListHmacCredentials(sdsaasService.NewListHmacCredentialsOptions())
+		Expect(err).To(BeNil())
+		_, _, err = sdsaasService.ListCertificates(sdsaasService.NewListCertificatesOptions())
+		Expect(err).To(BeNil())
+		_, _, err = sdsaasService.ListVolumes(sdsaasService.NewListVolumesOptions())
+		Expect(err).To(BeNil())
+		Expect(versions).To(Equal(map[string]string{
+			"/s3_credentials": "2026-01-01",
+			"/certificates":   "2026-03-01",
+			"/volumes":        "2026-02-01",
+		}))
+
+		// Pins on a clone do not affect the original.
+		clone := sdsaasService.Clone()
+		clone.SetAPIVersion("ListCertificates", "")
+		Expect(clone.GetAPIVersion("ListCertificates")).To(Equal("2026-01-01"))
+		Expect(sdsaasService.GetAPIVersion("ListCertificates")).To(Equal("2026-03-01"))
+	})
+	It(`Reports the version used by the server and its deprecation`, func() {
+		sdsaasService := newService(nil)
+		_, response, err := sdsaasService.ListVolumes(sdsaasService.NewListVolumesOptions())
+		Expect(err).To(BeNil())
+		info := sdsaasv2.GetAPIVersionInfo(response)
+		Expect(info.Version).To(Equal("2025-02-01"))
+		Expect(info.Deprecated).To(BeFalse())
+		Expect(info.SunsetDate).To(BeNil())
+
+		_, response, err = sdsaasService.ListHmacCredentials(sdsaasService.NewListHmacCredentialsOptions())
+		Expect(err).To(BeNil())
+		info = sdsaasv2.GetAPIVersionInfo(response)
+		Expect(info.Deprecated).To(BeTrue())
+		Expect(*info.DeprecationDate).To(Equal(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+		Expect(*info.SunsetDate).To(Equal(time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)))
+		Expect(info.Link).To(ContainSubstring(`rel="deprecation"`))
+
+		Expect(sdsaasv2.GetAPIVersionInfo(nil)).To(BeNil())
+	})
+})
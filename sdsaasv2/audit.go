@@ -0,0 +1,412 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+)
+
+// The maximum size of a response body read for its trace and resource ID.
+const maxAuditResponseBodySize = 1 << 20
+
+// ErrAuditTrailTampered is matched by the errors of VerifyAuditTrail for a trail that was modified.
+var ErrAuditTrailTampered = errors.New("the audit trail was tampered with")
+
+// AuditRecord : A record of a mutating operation in an audit trail.
+type AuditRecord struct {
+	// The position of the record in the trail, starting at 1.
+	Sequence int64 `json:"sequence"`
+
+	// When the operation was sent.
+	Time time.Time `json:"time"`
+
+	// The operation ID, e.g. "CreateVolume"; empty for a request of an unknown operation.
+	OperationID string `json:"operation_id,omitempty"`
+
+	// The HTTP method.
+	Method string `json:"method"`
+
+	// The request URL.
+	URL string `json:"url"`
+
+	// The IDs of the resources the operation acts on, by path parameter, e.g. "volume_id", including the ID of a
+	// created resource.
+	ResourceIDs map[string]string `json:"resource_ids,omitempty"`
+
+	// The request body, with secrets redacted.
+	RequestBody string `json:"request_body,omitempty"`
+
+	// The response status code; 0 if no response was received.
+	Status int `json:"status"`
+
+	// The error of a request that received no response.
+	Error string `json:"error,omitempty"`
+
+	// The trace ID of the service, from the X-Request-Id or X-Correlation-Id response header, or the trace of an
+	// error response.
+	TraceID string `json:"trace_id,omitempty"`
+
+	// The identity of the caller: the IAM ID or subject of a bearer token, the user name of basic authentication, or
+	// else the type of the authenticator.
+	Caller string `json:"caller,omitempty"`
+
+	// The hash of the previous record; empty for the first record.
+	PreviousHash string `json:"previous_hash"`
+
+	// The hex HMAC-SHA256, with the key of the trail, of the JSON encoding of the record without its hash; or the
+	// hex SHA-256 of it for a trail without a key.
+	Hash string `json:"hash"`
+}
+
+// computeHash returns the hash of the record, keyed with the key when it is not empty.
+func (record *AuditRecord) computeHash(key []byte) (string, error) {
+	unhashed := *record
+	unhashed.Hash = ""
+	data, err := json.Marshal(&unhashed)
+	if err != nil {
+		return "", err
+	}
+	if len(key) == 0 {
+		sum := sha256.Sum256(data)
+		return hex.EncodeToString(sum[:]), nil
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// AuditAnchor : The position and hash of the last record of an audit trail, kept apart from the trail, e.g. in
+// another system, to detect that records were cut off the end of the trail.
+type AuditAnchor struct {
+	Sequence int64  `json:"sequence"`
+	Hash     string `json:"hash"`
+}
+
+// AuditTrailOptions : The options of an audit trail, which must be the same when it is written, resumed and verified.
+type AuditTrailOptions struct {
+	// The key of the HMAC-SHA256 that chains the records. Without a key, anyone who can write the trail can also
+	// rewrite a record and recompute the hashes of the records after it, so VerifyAuditTrail only detects
+	// accidental changes.
+	Key []byte
+
+	// The anchor of the trail, see AuditTrail.Anchor. ResumeAuditTrail and VerifyAuditTrail check that the trail
+	// holds the record of the anchor. Records cut off the end of the trail are only detected with an anchor.
+	Anchor *AuditAnchor
+}
+
+// AuditTrail : An append-only, hash-chained JSON Lines log of the mutating operations of a service, see
+// SdsaasV2.SetAuditTrail. Each record holds the hash of the previous one, so that VerifyAuditTrail detects records
+// that were modified, removed or reordered. The chain is keyed with the Key of the options, and records cut off
+// the end are detected against an anchor exported with Anchor.
+type AuditTrail struct {
+	mutex    sync.Mutex
+	writer   io.Writer
+	key      []byte
+	sequence int64
+	lastHash string
+}
+
+// NewAuditTrail : constructs an AuditTrail that starts a new chain in the writer. The options may be nil.
+func NewAuditTrail(writer io.Writer, options *AuditTrailOptions) *AuditTrail {
+	trail := &AuditTrail{writer: writer}
+	if options != nil {
+		trail.key = options.Key
+	}
+	return trail
+}
+
+// ResumeAuditTrail : constructs an AuditTrail that continues the chain of an existing trail, after verifying it.
+// The writer usually appends to the file the reader has read. The options may be nil.
+func ResumeAuditTrail(reader io.Reader, writer io.Writer, options *AuditTrailOptions) (*AuditTrail, error) {
+	last, err := verifyAuditTrail(reader, options)
+	if err != nil {
+		return nil, err
+	}
+	trail := NewAuditTrail(writer, options)
+	if last != nil {
+		trail.sequence, trail.lastHash = last.Sequence, last.Hash
+	}
+	return trail, nil
+}
+
+// Anchor returns the anchor of the last record appended to the trail, to be stored apart from it.
+func (trail *AuditTrail) Anchor() AuditAnchor {
+	trail.mutex.Lock()
+	defer trail.mutex.Unlock()
+	return AuditAnchor{Sequence: trail.sequence, Hash: trail.lastHash}
+}
+
+// Append chains a record to the trail and writes it. The sequence and hashes of the record are set.
+func (trail *AuditTrail) Append(record *AuditRecord) error {
+	trail.mutex.Lock()
+	defer trail.mutex.Unlock()
+
+	record.Sequence = trail.sequence + 1
+	record.PreviousHash = trail.lastHash
+	hash, err := record.computeHash(trail.key)
+	if err != nil {
+		return core.SDKErrorf(err, "", "audit-marshal-error", common.GetComponentInfo())
+	}
+	record.Hash = hash
+	line, err := json.Marshal(record)
+	if err != nil {
+		return core.SDKErrorf(err, "", "audit-marshal-error", common.GetComponentInfo())
+	}
+	_, err = trail.writer.Write(append(line, '\n'))
+	if err != nil {
+		return core.SDKErrorf(err, "", "audit-write-error", common.GetComponentInfo())
+	}
+	trail.sequence, trail.lastHash = record.Sequence, record.Hash
+	return nil
+}
+
+// VerifyAuditTrail checks the chain of an audit trail, with the key and anchor of the options, and returns its
+// number of valid records. The error of a trail that was modified matches ErrAuditTrailTampered, and gives the line
+// of the first invalid record. The options may be nil.
+func VerifyAuditTrail(reader io.Reader, options *AuditTrailOptions) (int64, error) {
+	last, err := verifyAuditTrail(reader, options)
+	if last == nil {
+		return 0, err
+	}
+	return last.Sequence, err
+}
+
+// verifyAuditTrail checks the chain of an audit trail and returns its last valid record, or nil if it is empty.
+func verifyAuditTrail(reader io.Reader, options *AuditTrailOptions) (last *AuditRecord, err error) {
+	if options == nil {
+		options = &AuditTrailOptions{}
+	}
+	tampered := func(line int, reason string) error {
+		return core.SDKErrorf(fmt.Errorf("%w: line %d: %s", ErrAuditTrailTampered, line, reason), "", "audit-trail-tampered", common.GetComponentInfo())
+	}
+
+	lines := 0
+	scanner := bufio.NewScanner(reader)
+	scanner.Buffer(nil, 16<<20)
+	for line := 1; scanner.Scan(); line++ {
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+		record := new(AuditRecord)
+		if err = json.Unmarshal(scanner.Bytes(), record); err != nil {
+			return last, tampered(line, "the record is not valid JSON")
+		}
+		expected := AuditRecord{Sequence: 1}
+		if last != nil {
+			expected = AuditRecord{Sequence: last.Sequence + 1, PreviousHash: last.Hash}
+		}
+		if record.Sequence != expected.Sequence {
+			return last, tampered(line, fmt.Sprintf("expected the record %d, found %d", expected.Sequence, record.Sequence))
+		}
+		if record.PreviousHash != expected.PreviousHash {
+			return last, tampered(line, "the previous hash does not match the previous record")
+		}
+		hash, err := record.computeHash(options.Key)
+		if err != nil || !hmac.Equal([]byte(hash), []byte(record.Hash)) {
+			return last, tampered(line, "the hash does not match the record")
+		}
+		if anchor := options.Anchor; anchor != nil && record.Sequence == anchor.Sequence && record.Hash != anchor.Hash {
+			return last, tampered(line, "the hash does not match the anchor")
+		}
+		last = record
+		lines = line
+	}
+	if err = scanner.Err(); err != nil {
+		return last, core.SDKErrorf(err, "", "audit-read-error", common.GetComponentInfo())
+	}
+	if anchor := options.Anchor; anchor != nil && (last == nil || last.Sequence < anchor.Sequence) {
+		return last, tampered(lines+1, fmt.Sprintf("the trail ends before the record %d of the anchor", anchor.Sequence))
+	}
+	return last, nil
+}
+
+// auditTransport : Records the mutating operations of the service in an audit trail.
+type auditTransport struct {
+	trail         *AuditTrail
+	authenticator core.Authenticator
+	next          http.RoundTripper
+}
+
+// RoundTrip sends a request and records it if it belongs to a mutating operation. The operation fails if the
+// record cannot be written, even though the request was sent.
+func (transport *auditTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	op := operationOf(req)
+	operationId := op.id
+	mutating := IsMutatingOperation(operationId)
+	if operationId == "" {
+		mutating = req.Method != http.MethodGet && req.Method != http.MethodHead
+	}
+	if !mutating {
+		return transport.next.RoundTrip(req)
+	}
+
+	record := &AuditRecord{
+		Time:        time.Now().UTC(),
+		OperationID: operationId,
+		Method:      req.Method,
+		URL:         req.URL.Redacted(),
+		Caller:      auditCaller(req, transport.authenticator),
+	}
+	if len(op.params) > 0 {
+		record.ResourceIDs = maps.Clone(op.params)
+	}
+	if req.Body != nil && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err == nil {
+			data, _ := io.ReadAll(body)
+			body.Close()
+			record.RequestBody = core.RedactSecrets(rePrivateKey.ReplaceAllString(string(data), dryRunRedacted))
+		}
+	}
+
+	res, err := transport.next.RoundTrip(req)
+	if err != nil {
+		record.Error = err.Error()
+	} else {
+		record.Status = res.StatusCode
+		auditResponse(res, record, op.resource)
+	}
+	if appendErr := transport.trail.Append(record); appendErr != nil {
+		if res != nil {
+			res.Body.Close()
+		}
+		return nil, appendErr
+	}
+	return res, err
+}
+
+func (transport *auditTransport) position() int {
+	return transportLayerAudit
+}
+
+func (transport *auditTransport) unwrap() http.RoundTripper {
+	return transport.next
+}
+
+func (transport *auditTransport) withNext(next http.RoundTripper) transportLayer {
+	return &auditTransport{trail: transport.trail, authenticator: transport.authenticator, next: next}
+}
+
+// auditResponse sets the trace ID of a record from a response and, for a created resource, its ID. The beginning
+// of the body is read and put back in front of the rest, so that the caller still reads the whole body.
+func auditResponse(res *http.Response, record *AuditRecord, resource string) {
+	record.TraceID = res.Header.Get("X-Request-Id")
+	if record.TraceID == "" {
+		record.TraceID = res.Header.Get("X-Correlation-Id")
+	}
+	if res.Body == nil || res.Body == http.NoBody {
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxAuditResponseBodySize))
+	res.Body = &prefixedBody{Reader: io.MultiReader(bytes.NewReader(body), res.Body), Closer: res.Body}
+	if err != nil {
+		return
+	}
+	var response struct {
+		ID    *string `json:"id"`
+		Trace *string `json:"trace"`
+	}
+	if json.Unmarshal(body, &response) != nil {
+		return
+	}
+	if record.TraceID == "" && response.Trace != nil {
+		record.TraceID = *response.Trace
+	}
+	if res.StatusCode < 300 && response.ID != nil && resource != "" {
+		if record.ResourceIDs == nil {
+			record.ResourceIDs = map[string]string{}
+		}
+		if _, ok := record.ResourceIDs[resource+"_id"]; !ok {
+			record.ResourceIDs[resource+"_id"] = *response.ID
+		}
+	}
+}
+
+// prefixedBody : A response body whose beginning was read ahead, and that closes the original body.
+type prefixedBody struct {
+	io.Reader
+	io.Closer
+}
+
+// auditCaller returns the identity of the caller of a request, from the credentials set by the authenticator.
+func auditCaller(req *http.Request, authenticator core.Authenticator) string {
+	authorization := req.Header.Get("Authorization")
+	if token, ok := strings.CutPrefix(authorization, "Bearer "); ok {
+		if identity := tokenIdentity(token); identity != "" {
+			return identity
+		}
+	}
+	if username, _, ok := req.BasicAuth(); ok {
+		return username
+	}
+	if authenticator != nil {
+		return authenticator.AuthenticationType()
+	}
+	return ""
+}
+
+// tokenIdentity returns the IAM ID or subject of a JWT, without verifying it, or "" if it is not a JWT.
+func tokenIdentity(token string) string {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return ""
+	}
+	var claims struct {
+		IamID   string `json:"iam_id"`
+		Subject string `json:"sub"`
+	}
+	if json.Unmarshal(payload, &claims) != nil {
+		return ""
+	}
+	if claims.IamID != "" {
+		return claims.IamID
+	}
+	return claims.Subject
+}
+
+// SetAuditTrail records the mutating operations of the service (see IsMutatingOperation) in an audit trail, or
+// stops recording when the trail is nil. A record is written once the response is received, with the IDs of the
+// resources, the redacted request body, the status and trace ID of the response, and the identity of the caller.
+// Requests that are retried are recorded once, and requests that are not sent in dry-run mode are not recorded.
+func (sdsaas *SdsaasV2) SetAuditTrail(trail *AuditTrail) {
+	if trail == nil {
+		sdsaas.setTransportLayer(transportLayerAudit, nil)
+	} else {
+		sdsaas.setTransportLayer(transportLayerAudit, &auditTransport{trail: trail, authenticator: sdsaas.Service.Options.Authenticator})
+	}
+}
@@ -0,0 +1,165 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Audit trail`, func() {
+	var (
+		testServer    *httptest.Server
+		sdsaasService *sdsaasv2.SdsaasV2
+		trail         bytes.Buffer
+		trailOptions  = &sdsaasv2.AuditTrailOptions{Key: []byte("audit-key")}
+	)
+	BeforeEach(func() {
+		trail.Reset()
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == http.MethodPost && req.URL.Path == "/volumes":
+				res.Header().Set("X-Request-Id", "req-1")
+				res.WriteHeader(201)
+				fmt.Fprint(res, `{"id": "vol-1", "name": "data"}`)
+			case req.Method == http.MethodPost:
+				res.WriteHeader(400)
+				fmt.Fprint(res, `{"errors": [{"code": "bad_request"}], "trace": "trace-2"}`)
+			case req.Method == http.MethodDelete:
+				res.WriteHeader(204)
+			case req.Method == http.MethodPatch:
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"id": "vol-1", "name": "%s"}`, strings.Repeat("x", 2<<20))
+			default:
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"id": "vol-1"}`)
+			}
+		}))
+		claims := base64.RawURLEncoding.EncodeToString([]byte(`{"iam_id": "IBMid-123", "sub": "ops@example.com"}`))
+		var err error
+		sdsaasService, err = sdsaasv2.NewSdsaasV2(&sdsaasv2.SdsaasV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.BearerTokenAuthenticator{BearerToken: "e30." + claims + ".c2ln"},
+		})
+		Expect(err).To(BeNil())
+		sdsaasService.SetAuditTrail(sdsaasv2.NewAuditTrail(&trail, trailOptions))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	records := func() (records []sdsaasv2.AuditRecord) {
+		for _, line := range strings.Split(strings.TrimSpace(trail.String()), "\n") {
+			var record sdsaasv2.AuditRecord
+			Expect(json.Unmarshal([]byte(line), &record)).To(Succeed())
+			records = append(records, record)
+		}
+		return
+	}
+
+	It(`Records mutating operations in a hash chain`, func() {
+		volume, _, err := sdsaasService.CreateVolume(sdsaasService.NewCreateVolumeOptions(10).SetName("data"))
+		Expect(err).To(BeNil())
+		Expect(*volume.ID).To(Equal("vol-1"))
+		_, _, err = sdsaasService.GetVolume(sdsaasService.NewGetVolumeOptions("vol-1"))
+		Expect(err).To(BeNil())
+		_, err = sdsaasService.DeleteVolumeMapping(sdsaasService.NewDeleteVolumeMappingOptions("host-1", "map-1"))
+		Expect(err).To(BeNil())
+		_, _, err = sdsaasService.CreateHmacCredentials(sdsaasService.NewCreateHmacCredentialsOptions("key-1"))
+		Expect(err).ToNot(BeNil())
+
+		recorded := records()
+		Expect(recorded).To(HaveLen(3))
+		Expect(recorded[0].Sequence).To(Equal(int64(1)))
+		Expect(recorded[0].OperationID).To(Equal("CreateVolume"))
+		Expect(recorded[0].ResourceIDs).To(Equal(map[string]string{"volume_id": "vol-1"}))
+		Expect(recorded[0].RequestBody).To(ContainSubstring(`"name":"data"`))
+		Expect(recorded[0].Status).To(Equal(201))
+		Expect(recorded[0].TraceID).To(Equal("req-1"))
+		Expect(recorded[0].Caller).To(Equal("IBMid-123"))
+		Expect(recorded[0].PreviousHash).To(BeEmpty())
+		Expect(recorded[1].OperationID).To(Equal("DeleteVolumeMapping"))
+		Expect(recorded[1].ResourceIDs).To(Equal(map[string]string{"host_id": "host-1", "volume_mapping_id": "map-1"}))
+		Expect(recorded[1].PreviousHash).To(Equal(recorded[0].Hash))
+		Expect(recorded[2].ResourceIDs).To(Equal(map[string]string{"access_key": "key-1"}))
+		Expect(recorded[2].Status).To(Equal(400))
+		Expect(recorded[2].TraceID).To(Equal("trace-2"))
+
+		count, err := sdsaasv2.VerifyAuditTrail(bytes.NewReader(trail.Bytes()), trailOptions)
+		Expect(err).To(BeNil())
+		Expect(count).To(Equal(int64(3)))
+	})
+	It(`Leaves large response bodies intact`, func() {
+		volume, _, err := sdsaasService.UpdateVolume(sdsaasService.NewUpdateVolumeOptions("vol-1", map[string]interface{}{"name": "big"}))
+		Expect(err).To(BeNil())
+		Expect(*volume.Name).To(HaveLen(2 << 20))
+		Expect(records()).To(HaveLen(1))
+	})
+	It(`Resumes a trail and detects tampering`, func() {
+		_, err := sdsaasService.DeleteVolume(sdsaasService.NewDeleteVolumeOptions("vol-1"))
+		Expect(err).To(BeNil())
+
+		var appended bytes.Buffer
+		resumed, err := sdsaasv2.ResumeAuditTrail(bytes.NewReader(trail.Bytes()), &appended, trailOptions)
+		Expect(err).To(BeNil())
+		sdsaasService.SetAuditTrail(resumed)
+		_, err = sdsaasService.DeleteVolume(sdsaasService.NewDeleteVolumeOptions("vol-2"))
+		Expect(err).To(BeNil())
+		trail.Write(appended.Bytes())
+		anchor := resumed.Anchor()
+		Expect(anchor.Sequence).To(Equal(int64(2)))
+		anchored := &sdsaasv2.AuditTrailOptions{Key: trailOptions.Key, Anchor: &anchor}
+		count, err := sdsaasv2.VerifyAuditTrail(bytes.NewReader(trail.Bytes()), anchored)
+		Expect(err).To(BeNil())
+		Expect(count).To(Equal(int64(2)))
+
+		tampered := strings.Replace(trail.String(), "vol-1", "vol-9", 1)
+		count, err = sdsaasv2.VerifyAuditTrail(strings.NewReader(tampered), trailOptions)
+		Expect(errors.Is(err, sdsaasv2.ErrAuditTrailTampered)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("line 1"))
+		Expect(count).To(BeZero())
+
+		lines := strings.SplitAfter(trail.String(), "\n")
+		count, err = sdsaasv2.VerifyAuditTrail(strings.NewReader(lines[1]), trailOptions)
+		Expect(errors.Is(err, sdsaasv2.ErrAuditTrailTampered)).To(BeTrue())
+		Expect(count).To(BeZero())
+		_, err = sdsaasv2.ResumeAuditTrail(strings.NewReader(tampered), &appended, trailOptions)
+		Expect(err).ToNot(BeNil())
+
+		// A chain rewritten without the key, or cut short, is detected.
+		_, err = sdsaasv2.VerifyAuditTrail(bytes.NewReader(trail.Bytes()), nil)
+		Expect(errors.Is(err, sdsaasv2.ErrAuditTrailTampered)).To(BeTrue())
+		count, err = sdsaasv2.VerifyAuditTrail(strings.NewReader(lines[0]), trailOptions)
+		Expect(err).To(BeNil())
+		Expect(count).To(Equal(int64(1)))
+		count, err = sdsaasv2.VerifyAuditTrail(strings.NewReader(lines[0]), anchored)
+		Expect(errors.Is(err, sdsaasv2.ErrAuditTrailTampered)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("ends before the record 2"))
+		Expect(count).To(Equal(int64(1)))
+	})
+})
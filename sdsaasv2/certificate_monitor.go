@@ -0,0 +1,406 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+)
+
+// DefaultCertificateMonitorInterval is the time between checks when CertificateMonitorOptions.Interval is not set.
+const DefaultCertificateMonitorInterval = time.Hour
+
+// DefaultCertificateMonitorThreshold is how long before expiry a certificate is rotated when
+// CertificateMonitorOptions.Threshold is not set.
+const DefaultCertificateMonitorThreshold = 14 * 24 * time.Hour
+
+// Constants associated with the CertificateEvent.Type property.
+// The kind of event emitted by a CertificateMonitor.
+const (
+	CertificateEventTypeCheckedConst        = "checked"
+	CertificateEventTypeErrorConst          = "error"
+	CertificateEventTypeExpiredConst        = "expired"
+	CertificateEventTypeExpiringConst       = "expiring"
+	CertificateEventTypeRollbackFailedConst = "rollback_failed"
+	CertificateEventTypeRolledBackConst     = "rolled_back"
+	CertificateEventTypeRotatedConst        = "rotated"
+	CertificateEventTypeRotationFailedConst = "rotation_failed"
+)
+
+// CertificateProvider : Supplies certificates to a CertificateMonitor.
+type CertificateProvider interface {
+	// NewCertificate returns a fresh certificate bundle to replace the certificate of the specified type.
+	NewCertificate(ctx context.Context, certType string, status *StatusResponse) (*SslCertificateBundle, error)
+
+	// CurrentCertificate returns the bundle that is currently installed for the specified type. It is uploaded again
+	// if the service accepts the new certificate but does not report it as rotated afterwards. Returning a nil bundle
+	// disables the rollback.
+	CurrentCertificate(ctx context.Context, certType string) (*SslCertificateBundle, error)
+}
+
+// CertificateEvent : An event emitted by a CertificateMonitor.
+type CertificateEvent struct {
+	// The kind of event.
+	Type string
+
+	// The certificate type the event refers to.
+	CertType string
+
+	// The certificate status at the time of the event, if known.
+	Status *StatusResponse
+
+	// The time remaining until the certificate expires; negative once it has expired.
+	ExpiresIn time.Duration
+
+	// The error that caused the event, if any.
+	Error error
+
+	// The time of the event.
+	Time time.Time
+}
+
+// CertificateMetrics : The latest observations of a CertificateMonitor for a single certificate type.
+type CertificateMetrics struct {
+	// The expiration date reported by the last successful check.
+	ExpirationDate time.Time
+
+	// The time remaining until expiry at the last successful check.
+	ExpiresIn time.Duration
+
+	// When set to true, the certificate was expired at the last successful check.
+	Expired bool
+
+	// The time of the last check.
+	LastChecked time.Time
+
+	// The number of successful rotations.
+	Rotations int64
+
+	// The number of failed checks and rotations.
+	Failures int64
+}
+
+// CertificateMonitorOptions : Options for a CertificateMonitor.
+type CertificateMonitorOptions struct {
+	// The time between checks. Defaults to DefaultCertificateMonitorInterval.
+	Interval time.Duration
+
+	// Certificates that expire within this duration are reported as expiring and rotated.
+	// Defaults to DefaultCertificateMonitorThreshold.
+	Threshold time.Duration
+
+	// Supplies replacement certificates. When nil, the monitor only reports expiry.
+	Provider CertificateProvider
+
+	// Called for every event. Must not block.
+	OnEvent func(CertificateEvent)
+
+	// Returns the current time; defaults to time.Now.
+	Now func() time.Time
+}
+
+// CertificateMonitor : Periodically checks every configured certificate, reports those that are close to expiry and
+// rotates them with certificates obtained from a CertificateProvider.
+type CertificateMonitor struct {
+	client  *SdsaasV2
+	options CertificateMonitorOptions
+
+	mutex   sync.Mutex
+	metrics map[string]CertificateMetrics
+}
+
+// NewCertificateMonitor : constructs a CertificateMonitor for the certificates configured in "sdsaas".
+func (sdsaas *SdsaasV2) NewCertificateMonitor(options *CertificateMonitorOptions) (monitor *CertificateMonitor, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	monitor = &CertificateMonitor{
+		client:  sdsaas,
+		options: *options,
+		metrics: map[string]CertificateMetrics{},
+	}
+	if monitor.options.Interval <= 0 {
+		monitor.options.Interval = DefaultCertificateMonitorInterval
+	}
+	if monitor.options.Threshold <= 0 {
+		monitor.options.Threshold = DefaultCertificateMonitorThreshold
+	}
+	if monitor.options.Now == nil {
+		monitor.options.Now = time.Now
+	}
+	return
+}
+
+// Run checks the certificates every Interval until the context is cancelled.
+func (monitor *CertificateMonitor) Run(ctx context.Context) error {
+	ticker := time.NewTicker(monitor.options.Interval)
+	defer ticker.Stop()
+	for {
+		_ = monitor.CheckWithContext(ctx)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// Check invokes CheckWithContext() using context.Background() as the Context parameter.
+func (monitor *CertificateMonitor) Check() (err error) {
+	err = monitor.CheckWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CheckWithContext checks every certificate returned by ListCertificates once, rotating those that expire within the
+// threshold. Problems with individual certificates are reported as events; the returned error is only set when the
+// certificates cannot be listed.
+func (monitor *CertificateMonitor) CheckWithContext(ctx context.Context) (err error) {
+	list, _, err := monitor.client.ListCertificatesWithContext(ctx, &ListCertificatesOptions{})
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-certificates-error")
+		monitor.emit(CertificateEvent{Type: CertificateEventTypeErrorConst, Error: err})
+		return
+	}
+	if list == nil {
+		return
+	}
+	for _, certType := range list.Certificates {
+		monitor.checkCertificate(ctx, certType)
+	}
+	return
+}
+
+// Metrics returns a copy of the latest observations, keyed by certificate type.
+func (monitor *CertificateMonitor) Metrics() map[string]CertificateMetrics {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	metrics := make(map[string]CertificateMetrics, len(monitor.metrics))
+	for certType, value := range monitor.metrics {
+		metrics[certType] = value
+	}
+	return metrics
+}
+
+func (monitor *CertificateMonitor) checkCertificate(ctx context.Context, certType string) {
+	status, err := monitor.status(ctx, certType)
+	if err != nil {
+		monitor.fail(CertificateEvent{Type: CertificateEventTypeErrorConst, CertType: certType, Error: err})
+		return
+	}
+
+	event := monitor.newEvent(certType, status)
+	switch {
+	case status.Expired != nil && *status.Expired:
+		event.Type = CertificateEventTypeExpiredConst
+	case status.ExpirationDate != nil && event.ExpiresIn <= monitor.options.Threshold:
+		event.Type = CertificateEventTypeExpiringConst
+	default:
+		event.Type = CertificateEventTypeCheckedConst
+	}
+	monitor.emit(event)
+
+	if event.Type != CertificateEventTypeCheckedConst && monitor.options.Provider != nil {
+		monitor.rotate(ctx, certType, status)
+	}
+}
+
+// rotate replaces the certificate of the specified type, and restores the current certificate if the service does
+// not report the new one as rotated afterwards, or rejects it but reports another certificate than before.
+func (monitor *CertificateMonitor) rotate(ctx context.Context, certType string, previous *StatusResponse) {
+	provider := monitor.options.Provider
+	bundle, err := provider.NewCertificate(ctx, certType, previous)
+	if err == nil && bundle == nil {
+		err = fmt.Errorf("the certificate provider did not return a certificate for '%s'", certType)
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "certificate-provider-error", common.GetComponentInfo())
+		monitor.fail(CertificateEvent{Type: CertificateEventTypeRotationFailedConst, CertType: certType, Status: previous, Error: err})
+		return
+	}
+
+	// Nothing has been uploaded yet, so a local validation failure needs no rollback.
+	body, err := bundle.Body()
+	if err != nil {
+		monitor.fail(CertificateEvent{Type: CertificateEventTypeRotationFailedConst, CertType: certType, Status: previous, Error: err})
+		return
+	}
+	current, currentErr := provider.CurrentCertificate(ctx, certType)
+
+	rejected, err := monitor.replace(ctx, certType, body)
+	if err != nil {
+		monitor.fail(CertificateEvent{Type: CertificateEventTypeRotationFailedConst, CertType: certType, Status: previous, Error: err})
+		// A request that failed uploaded nothing. A rejected certificate should not have been installed, which is
+		// checked against the status: the current certificate is restored unless the status is unchanged.
+		if rejected {
+			if status, statusErr := monitor.status(ctx, certType); statusErr != nil || !sameCertificateStatus(previous, status) {
+				monitor.rollback(ctx, certType, previous, current, currentErr)
+			}
+		}
+		return
+	}
+	status, err := monitor.status(ctx, certType)
+	if err == nil {
+		err = monitor.checkRotated(certType, previous, status)
+	}
+	if err == nil {
+		monitor.mutex.Lock()
+		metrics := monitor.metrics[certType]
+		metrics.Rotations++
+		monitor.metrics[certType] = metrics
+		monitor.mutex.Unlock()
+		monitor.emit(monitor.newEvent(certType, status).withType(CertificateEventTypeRotatedConst))
+		return
+	}
+	monitor.fail(CertificateEvent{Type: CertificateEventTypeRotationFailedConst, CertType: certType, Status: previous, Error: err})
+	monitor.rollback(ctx, certType, previous, current, currentErr)
+}
+
+// rollback uploads the current certificate again, if the provider returned it.
+func (monitor *CertificateMonitor) rollback(ctx context.Context, certType string, previous *StatusResponse, current *SslCertificateBundle, currentErr error) {
+	if currentErr != nil || current == nil {
+		return
+	}
+	body, err := current.Body()
+	if err == nil {
+		_, err = monitor.replace(ctx, certType, body)
+	}
+	if err != nil {
+		monitor.fail(CertificateEvent{Type: CertificateEventTypeRollbackFailedConst, CertType: certType, Status: previous, Error: err})
+		return
+	}
+	monitor.emit(monitor.newEvent(certType, previous).withType(CertificateEventTypeRolledBackConst))
+}
+
+// sameCertificateStatus reports whether two statuses show the same expiration date and expiry.
+func sameCertificateStatus(status *StatusResponse, other *StatusResponse) bool {
+	if (status.Expired != nil && *status.Expired) != (other.Expired != nil && *other.Expired) {
+		return false
+	}
+	if status.ExpirationDate == nil || other.ExpirationDate == nil {
+		return status.ExpirationDate == nil && other.ExpirationDate == nil
+	}
+	return time.Time(*status.ExpirationDate).Equal(time.Time(*other.ExpirationDate))
+}
+
+// checkRotated returns an error unless the status after a rotation shows a certificate that is not expired, expires
+// after the rotation threshold and expires after the previous certificate.
+func (monitor *CertificateMonitor) checkRotated(certType string, previous *StatusResponse, status *StatusResponse) (err error) {
+	var errMsg string
+	switch {
+	case status.Expired != nil && *status.Expired:
+		errMsg = fmt.Sprintf("the certificate for '%s' is still reported as expired after rotation", certType)
+	case status.ExpirationDate == nil:
+		errMsg = fmt.Sprintf("the certificate for '%s' has no expiration date after rotation", certType)
+	case !time.Time(*status.ExpirationDate).After(monitor.options.Now().Add(monitor.options.Threshold)):
+		errMsg = fmt.Sprintf("the certificate for '%s' still expires within the rotation threshold after rotation", certType)
+	case previous.ExpirationDate != nil && !time.Time(*status.ExpirationDate).After(time.Time(*previous.ExpirationDate)):
+		errMsg = fmt.Sprintf("the certificate for '%s' does not expire after the previous certificate", certType)
+	default:
+		return
+	}
+	err = core.SDKErrorf(nil, errMsg, "certificate-not-rotated", common.GetComponentInfo())
+	return
+}
+
+// replace uploads a certificate body, treating a response that flags the certificate or key as invalid as an error,
+// and reporting it as rejected.
+func (monitor *CertificateMonitor) replace(ctx context.Context, certType string, body io.ReadCloser) (rejected bool, err error) {
+	result, _, err := monitor.client.ReplaceSslCertWithContext(ctx, monitor.client.NewReplaceSslCertOptions(certType).SetBody(body))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "replace-certificate-error")
+		return
+	}
+	if result == nil {
+		return
+	}
+	if len(result.Errors) > 0 {
+		errMsg := fmt.Sprintf("the certificate for '%s' was rejected: %s", certType, core.StringNilMapper(result.Errors[0].Message))
+		err = core.SDKErrorf(nil, errMsg, "certificate-rejected", common.GetComponentInfo())
+		return true, err
+	}
+	if (result.ValidCertificate != nil && !*result.ValidCertificate) || (result.ValidKey != nil && !*result.ValidKey) {
+		errMsg := fmt.Sprintf("the certificate or key for '%s' was reported as invalid", certType)
+		err = core.SDKErrorf(nil, errMsg, "certificate-rejected", common.GetComponentInfo())
+		rejected = true
+	}
+	return
+}
+
+func (monitor *CertificateMonitor) status(ctx context.Context, certType string) (status *StatusResponse, err error) {
+	status, _, err = monitor.client.GetS3SslCertStatusWithContext(ctx, monitor.client.NewGetS3SslCertStatusOptions(certType))
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "certificate-status-error")
+		return
+	}
+	if status == nil {
+		status = &StatusResponse{}
+	}
+
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	metrics := monitor.metrics[certType]
+	metrics.LastChecked = monitor.options.Now()
+	metrics.Expired = status.Expired != nil && *status.Expired
+	if status.ExpirationDate != nil {
+		metrics.ExpirationDate = time.Time(*status.ExpirationDate)
+		metrics.ExpiresIn = metrics.ExpirationDate.Sub(metrics.LastChecked)
+	}
+	monitor.metrics[certType] = metrics
+	return
+}
+
+func (monitor *CertificateMonitor) newEvent(certType string, status *StatusResponse) CertificateEvent {
+	event := CertificateEvent{
+		CertType: certType,
+		Status:   status,
+	}
+	if status != nil && status.ExpirationDate != nil {
+		event.ExpiresIn = time.Time(*status.ExpirationDate).Sub(monitor.options.Now())
+	}
+	return event
+}
+
+func (event CertificateEvent) withType(eventType string) CertificateEvent {
+	event.Type = eventType
+	return event
+}
+
+func (monitor *CertificateMonitor) fail(event CertificateEvent) {
+	monitor.mutex.Lock()
+	metrics := monitor.metrics[event.CertType]
+	metrics.Failures++
+	monitor.metrics[event.CertType] = metrics
+	monitor.mutex.Unlock()
+	monitor.emit(event)
+}
+
+func (monitor *CertificateMonitor) emit(event CertificateEvent) {
+	event.Time = monitor.options.Now()
+	if monitor.options.OnEvent != nil {
+		monitor.options.OnEvent(event)
+	}
+}
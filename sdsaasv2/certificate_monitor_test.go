@@ -0,0 +1,245 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// testCertificateProvider returns fixed bundles to a CertificateMonitor.
+type testCertificateProvider struct {
+	next    *sdsaasv2.SslCertificateBundle
+	current *sdsaasv2.SslCertificateBundle
+}
+
+func (p *testCertificateProvider) NewCertificate(ctx context.Context, certType string, status *sdsaasv2.StatusResponse) (*sdsaasv2.SslCertificateBundle, error) {
+	return p.next, nil
+}
+
+func (p *testCertificateProvider) CurrentCertificate(ctx context.Context, certType string) (*sdsaasv2.SslCertificateBundle, error) {
+	return p.current, nil
+}
+
+var _ = Describe(`CertificateMonitor`, func() {
+	var (
+		testServer     *httptest.Server
+		sdsaasService  *sdsaasv2.SdsaasV2
+		expirationDate string
+		rotatedDate    string
+		rejectUploads  bool
+		installRejects bool
+		failRollback   bool
+		uploads        []string
+		events         []sdsaasv2.CertificateEvent
+		provider       *testCertificateProvider
+	)
+	now := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		uploads = nil
+		events = nil
+		rejectUploads = false
+		installRejects = false
+		failRollback = false
+		expirationDate = "2026-03-05T00:00:00.000Z"
+		rotatedDate = "2026-06-01T00:00:00.000Z"
+
+		expiry := time.Now().Add(90 * 24 * time.Hour)
+		issuer := newTestCertificate("issuer", nil, expiry)
+		oldLeaf := newTestCertificate("s3.example.com", issuer, expiry, "s3.example.com")
+		newLeaf := newTestCertificate("s3.example.com", issuer, expiry, "s3.example.com")
+		provider = &testCertificateProvider{
+			current: sdsaasv2.NewSslCertificateBundle(oldLeaf.certificatePEM(), issuer.certificatePEM(), oldLeaf.keyPEM()),
+			next:    sdsaasv2.NewSslCertificateBundle(newLeaf.certificatePEM(), issuer.certificatePEM(), newLeaf.keyPEM()),
+		}
+
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			switch {
+			case req.Method == "GET" && req.URL.Path == "/certificates":
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"certificates": ["s3"]}`)
+			case req.Method == "GET" && req.URL.Path == "/certificates/s3":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"name": "s3", "expired": false, "expiration_date": "%s"}`, expirationDate)
+			case req.Method == "PUT" && req.URL.Path == "/certificates/s3":
+				body, err := io.ReadAll(req.Body)
+				Expect(err).To(BeNil())
+				uploads = append(uploads, string(body))
+				if failRollback && len(uploads) == 2 {
+					res.WriteHeader(500)
+					fmt.Fprint(res, `{"errors": [{"code": "internal_error"}]}`)
+					return
+				}
+				res.WriteHeader(200)
+				if rejectUploads && len(uploads) == 1 {
+					if installRejects {
+						expirationDate = rotatedDate
+					}
+					fmt.Fprint(res, `{"errors": [], "valid_certificate": false, "valid_key": true}`)
+					return
+				}
+				expirationDate = rotatedDate
+				fmt.Fprint(res, `{"errors": [], "valid_certificate": true, "valid_key": true}`)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.Path)
+			}
+		}))
+
+		var serviceErr error
+		sdsaasService, serviceErr = sdsaasv2.NewSdsaasV2(&sdsaasv2.SdsaasV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	newMonitor := func(provider sdsaasv2.CertificateProvider) *sdsaasv2.CertificateMonitor {
+		monitor, err := sdsaasService.NewCertificateMonitor(&sdsaasv2.CertificateMonitorOptions{
+			Threshold: 7 * 24 * time.Hour,
+			Provider:  provider,
+			OnEvent:   func(event sdsaasv2.CertificateEvent) { events = append(events, event) },
+			Now:       func() time.Time { return now },
+		})
+		Expect(err).To(BeNil())
+		return monitor
+	}
+	eventTypes := func() (types []string) {
+		for _, event := range events {
+			types = append(types, event.Type)
+		}
+		return
+	}
+
+	It(`Reports certificates that are not close to expiry`, func() {
+		expirationDate = "2026-05-01T00:00:00.000Z"
+		monitor := newMonitor(provider)
+		Expect(monitor.Check()).To(BeNil())
+		Expect(eventTypes()).To(Equal([]string{sdsaasv2.CertificateEventTypeCheckedConst}))
+		Expect(uploads).To(BeEmpty())
+		Expect(monitor.Metrics()["s3"].ExpiresIn).To(Equal(61 * 24 * time.Hour))
+	})
+	It(`Reports expiring certificates without a provider`, func() {
+		monitor := newMonitor(nil)
+		Expect(monitor.Check()).To(BeNil())
+		Expect(eventTypes()).To(Equal([]string{sdsaasv2.CertificateEventTypeExpiringConst}))
+		Expect(events[0].ExpiresIn).To(Equal(4 * 24 * time.Hour))
+		Expect(uploads).To(BeEmpty())
+	})
+	It(`Rotates expiring certificates`, func() {
+		monitor := newMonitor(provider)
+		Expect(monitor.Check()).To(BeNil())
+		Expect(eventTypes()).To(Equal([]string{
+			sdsaasv2.CertificateEventTypeExpiringConst,
+			sdsaasv2.CertificateEventTypeRotatedConst,
+		}))
+		next, err := provider.next.PEM()
+		Expect(err).To(BeNil())
+		Expect(uploads).To(Equal([]string{string(next)}))
+		Expect(monitor.Metrics()["s3"].Rotations).To(Equal(int64(1)))
+		Expect(monitor.Metrics()["s3"].ExpirationDate).To(Equal(time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)))
+	})
+	It(`Does not roll back a rejected certificate that left the status unchanged`, func() {
+		rejectUploads = true
+		monitor := newMonitor(provider)
+		Expect(monitor.Check()).To(BeNil())
+		Expect(eventTypes()).To(Equal([]string{
+			sdsaasv2.CertificateEventTypeExpiringConst,
+			sdsaasv2.CertificateEventTypeRotationFailedConst,
+		}))
+		Expect(uploads).To(HaveLen(1))
+		Expect(monitor.Metrics()["s3"].Failures).To(Equal(int64(1)))
+	})
+	It(`Rolls back a rejected certificate that changed the status`, func() {
+		rejectUploads = true
+		installRejects = true
+		monitor := newMonitor(provider)
+		Expect(monitor.Check()).To(BeNil())
+		Expect(eventTypes()).To(Equal([]string{
+			sdsaasv2.CertificateEventTypeExpiringConst,
+			sdsaasv2.CertificateEventTypeRotationFailedConst,
+			sdsaasv2.CertificateEventTypeRolledBackConst,
+		}))
+		Expect(events[1].Error.Error()).To(ContainSubstring("reported as invalid"))
+		current, err := provider.current.PEM()
+		Expect(err).To(BeNil())
+		Expect(uploads).To(HaveLen(2))
+		Expect(uploads[1]).To(Equal(string(current)))
+	})
+	It(`Rolls back a new certificate that still expires within the threshold`, func() {
+		rotatedDate = "2026-03-06T00:00:00.000Z"
+		monitor := newMonitor(provider)
+		Expect(monitor.Check()).To(BeNil())
+		Expect(eventTypes()).To(Equal([]string{
+			sdsaasv2.CertificateEventTypeExpiringConst,
+			sdsaasv2.CertificateEventTypeRotationFailedConst,
+			sdsaasv2.CertificateEventTypeRolledBackConst,
+		}))
+		Expect(events[1].Error.Error()).To(ContainSubstring("within the rotation threshold"))
+		current, err := provider.current.PEM()
+		Expect(err).To(BeNil())
+		Expect(uploads).To(HaveLen(2))
+		Expect(uploads[1]).To(Equal(string(current)))
+		Expect(monitor.Metrics()["s3"].Rotations).To(BeZero())
+	})
+	It(`Reports a failed rollback`, func() {
+		// The service reports the same certificate after the upload.
+		rotatedDate = expirationDate
+		failRollback = true
+		monitor := newMonitor(provider)
+		Expect(monitor.Check()).To(BeNil())
+		Expect(eventTypes()).To(Equal([]string{
+			sdsaasv2.CertificateEventTypeExpiringConst,
+			sdsaasv2.CertificateEventTypeRotationFailedConst,
+			sdsaasv2.CertificateEventTypeRollbackFailedConst,
+		}))
+		Expect(events[2].Error).ToNot(BeNil())
+		Expect(monitor.Metrics()["s3"].Failures).To(Equal(int64(2)))
+	})
+	It(`Does not upload certificates that fail local validation`, func() {
+		provider.next.PrivateKey = provider.current.PrivateKey
+		monitor := newMonitor(provider)
+		Expect(monitor.Check()).To(BeNil())
+		Expect(eventTypes()).To(Equal([]string{
+			sdsaasv2.CertificateEventTypeExpiringConst,
+			sdsaasv2.CertificateEventTypeRotationFailedConst,
+		}))
+		Expect(uploads).To(BeEmpty())
+	})
+	It(`Stops running when the context is cancelled`, func() {
+		monitor := newMonitor(nil)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		Expect(monitor.Run(ctx)).To(Equal(context.Canceled))
+		Expect(events).To(HaveLen(1))
+	})
+})
@@ -0,0 +1,253 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+)
+
+// HeaderNameDryRun is the header of the synthetic responses returned in dry-run mode.
+const HeaderNameDryRun = "X-Sds-Dry-Run"
+
+// The value recorded in place of secrets.
+const dryRunRedacted = "[redacted]"
+
+var rePrivateKey = regexp.MustCompile(`(?s)-----BEGIN [A-Z ]*PRIVATE KEY-----.*?-----END [A-Z ]*PRIVATE KEY-----`)
+
+// DryRunRequest : A request of a mutating operation that was not sent in dry-run mode.
+type DryRunRequest struct {
+	// The operation ID, e.g. "CreateVolume"; empty for a request of an unknown operation.
+	OperationID string `json:"operation_id,omitempty"`
+
+	// The HTTP method.
+	Method string `json:"method"`
+
+	// The request URL.
+	URL string `json:"url"`
+
+	// The request headers, with credentials redacted.
+	Headers http.Header `json:"headers"`
+
+	// The request body, with secrets redacted.
+	Body string `json:"body,omitempty"`
+
+	// When the request was recorded.
+	Time time.Time `json:"time"`
+}
+
+// DryRunSink : Receives the requests that are not sent in dry-run mode.
+type DryRunSink interface {
+	// Record records a request. An error is returned by the operation that made the request.
+	Record(request *DryRunRequest) error
+}
+
+// RecordingDryRunSink : A DryRunSink that keeps the requests in memory.
+type RecordingDryRunSink struct {
+	mutex    sync.Mutex
+	requests []DryRunRequest
+}
+
+// NewRecordingDryRunSink : constructs an empty RecordingDryRunSink.
+func NewRecordingDryRunSink() *RecordingDryRunSink {
+	return &RecordingDryRunSink{}
+}
+
+// Record appends the request to the recorded requests.
+func (sink *RecordingDryRunSink) Record(request *DryRunRequest) error {
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+	sink.requests = append(sink.requests, *request)
+	return nil
+}
+
+// Requests returns the recorded requests, oldest first.
+func (sink *RecordingDryRunSink) Requests() []DryRunRequest {
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+	return append([]DryRunRequest(nil), sink.requests...)
+}
+
+// Reset removes the recorded requests.
+func (sink *RecordingDryRunSink) Reset() {
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+	sink.requests = nil
+}
+
+// JSONDryRunSink : A DryRunSink that writes each request as a line of JSON.
+type JSONDryRunSink struct {
+	mutex  sync.Mutex
+	writer io.Writer
+}
+
+// NewJSONDryRunSink : constructs a JSONDryRunSink writing to the writer.
+func NewJSONDryRunSink(writer io.Writer) *JSONDryRunSink {
+	return &JSONDryRunSink{writer: writer}
+}
+
+// Record writes the request as a line of JSON.
+func (sink *JSONDryRunSink) Record(request *DryRunRequest) error {
+	line, err := json.Marshal(request)
+	if err != nil {
+		return core.SDKErrorf(err, "", "dry-run-marshal-error", common.GetComponentInfo())
+	}
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+	_, err = sink.writer.Write(append(line, '\n'))
+	if err != nil {
+		return core.SDKErrorf(err, "", "dry-run-write-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// IsMutatingOperation reports whether an operation changes the state of the service: the Create, Update, Delete
+// and Replace operations.
+func IsMutatingOperation(operationId string) bool {
+	for _, prefix := range []string{"Create", "Update", "Delete", "Replace"} {
+		if strings.HasPrefix(operationId, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// dryRunTransport : Records the requests of mutating operations instead of sending them.
+type dryRunTransport struct {
+	sink DryRunSink
+	next http.RoundTripper
+}
+
+// RoundTrip sends the requests of read operations, and records the others and returns a synthetic response.
+func (transport *dryRunTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	operationId := operationOf(req).id
+	mutating := IsMutatingOperation(operationId)
+	if operationId == "" {
+		mutating = req.Method != http.MethodGet && req.Method != http.MethodHead
+	}
+	if !mutating {
+		return transport.next.RoundTrip(req)
+	}
+
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	headers := req.Header.Clone()
+	for name := range headers {
+		if isSecretHeader(name) {
+			headers[name] = []string{dryRunRedacted}
+		}
+	}
+	err := transport.sink.Record(&DryRunRequest{
+		OperationID: operationId,
+		Method:      req.Method,
+		URL:         req.URL.Redacted(),
+		Headers:     headers,
+		Body:        core.RedactSecrets(rePrivateKey.ReplaceAllString(string(body), dryRunRedacted)),
+		Time:        time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return dryRunResponse(req, body), nil
+}
+
+func (transport *dryRunTransport) position() int {
+	return transportLayerDryRun
+}
+
+func (transport *dryRunTransport) unwrap() http.RoundTripper {
+	return transport.next
+}
+
+func (transport *dryRunTransport) withNext(next http.RoundTripper) transportLayer {
+	return &dryRunTransport{sink: transport.sink, next: next}
+}
+
+// isSecretHeader reports whether a request header holds credentials.
+func isSecretHeader(name string) bool {
+	name = http.CanonicalHeaderKey(name)
+	return name == "Authorization" || name == "Cookie" || name == "Proxy-Authorization" || strings.HasPrefix(name, "X-Auth")
+}
+
+// dryRunResponse returns the synthetic response of a request that was not sent: 204 without a body for a delete,
+// else 201 for a create and 200 for an update, echoing a JSON request body.
+func dryRunResponse(req *http.Request, body []byte) *http.Response {
+	res := &http.Response{
+		Status:     "200 OK",
+		StatusCode: http.StatusOK,
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{},
+		Body:       http.NoBody,
+		Request:    req,
+	}
+	res.Header.Set(HeaderNameDryRun, "true")
+	switch req.Method {
+	case http.MethodDelete:
+		res.Status, res.StatusCode = "204 No Content", http.StatusNoContent
+		return res
+	case http.MethodPost:
+		res.Status, res.StatusCode = "201 Created", http.StatusCreated
+	}
+	if !json.Valid(body) || !bytes.HasPrefix(bytes.TrimSpace(body), []byte("{")) {
+		body = []byte("{}")
+	}
+	res.Header.Set("Content-Type", "application/json")
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	res.ContentLength = int64(len(body))
+	return res
+}
+
+// SetDryRun switches the service to dry-run mode, or back to normal mode when the sink is nil. In dry-run mode the
+// requests of mutating operations (see IsMutatingOperation) are built and validated as usual, then recorded to the
+// sink instead of being sent; the operation returns a synthetic response, for which IsDryRunResponse is true. The
+// result of a create or update operation holds the request body, and lacks the properties set by the service.
+// Read operations are sent as usual.
+func (sdsaas *SdsaasV2) SetDryRun(sink DryRunSink) {
+	if sink == nil {
+		sdsaas.setTransportLayer(transportLayerDryRun, nil)
+	} else {
+		sdsaas.setTransportLayer(transportLayerDryRun, &dryRunTransport{sink: sink})
+	}
+}
+
+// IsDryRun reports whether the service is in dry-run mode.
+func (sdsaas *SdsaasV2) IsDryRun() bool {
+	return sdsaas.getTransportLayer(transportLayerDryRun) != nil
+}
+
+// IsDryRunResponse reports whether a response is the synthetic response of a request that was not sent.
+func IsDryRunResponse(response *core.DetailedResponse) bool {
+	return response != nil && response.GetHeaders().Get(HeaderNameDryRun) == "true"
+}
@@ -0,0 +1,197 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+)
+
+// Constants associated with the endpoint type of a service URL.
+const (
+	EndpointTypeDirectConst  = "direct"
+	EndpointTypePrivateConst = "private"
+	EndpointTypePublicConst  = "public"
+)
+
+// External configuration properties (e.g. SDSAAS_REGION in the environment) read by
+// NewSdsaasV2UsingExternalConfig to resolve the service URL when no URL is configured.
+const (
+	PropertyRegion          = "REGION"
+	PropertyInstanceID      = "INSTANCE_ID"
+	PropertyEndpointType    = "ENDPOINT_TYPE"
+	PropertyEndpointCatalog = "ENDPOINT_CATALOG"
+)
+
+// DefaultEndpointCatalog is the catalog used by an EndpointResolver unless another one is given. It has no entries,
+// since the service endpoints are not part of the API definition: resolving a service URL from the REGION,
+// INSTANCE_ID and ENDPOINT_TYPE properties requires a catalog file, given by the ENDPOINT_CATALOG property or loaded
+// with LoadEndpointCatalog, or an explicit EndpointCatalog. Entries added to it at startup apply to every resolver
+// that uses it.
+var DefaultEndpointCatalog = &EndpointCatalog{}
+
+// EndpointCatalog : The URL templates used to build service URLs. Templates may contain the {region} and
+// {instance_id} placeholders.
+type EndpointCatalog struct {
+	// URL templates by endpoint type.
+	Templates map[string]string `json:"templates,omitempty"`
+
+	// URL templates by region and endpoint type, used instead of Templates for those regions.
+	RegionTemplates map[string]map[string]string `json:"region_templates,omitempty"`
+
+	// The known regions. Any region is accepted when empty.
+	Regions []string `json:"regions,omitempty"`
+}
+
+// LoadEndpointCatalog reads a JSON catalog file and lays it over the DefaultEndpointCatalog: its templates replace
+// the default ones of the same endpoint type (and region), and its regions are added to the default ones.
+func LoadEndpointCatalog(path string) (catalog *EndpointCatalog, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-catalog-error", common.GetComponentInfo())
+		return
+	}
+	overrides := &EndpointCatalog{}
+	err = json.Unmarshal(data, overrides)
+	if err != nil {
+		err = core.SDKErrorf(err, fmt.Sprintf("%s is not a valid endpoint catalog", path), "catalog-format-error", common.GetComponentInfo())
+		return
+	}
+	catalog = DefaultEndpointCatalog.Merge(overrides)
+	return
+}
+
+// isEmpty reports whether the catalog has no URL templates.
+func (catalog *EndpointCatalog) isEmpty() bool {
+	if len(catalog.Templates) > 0 {
+		return false
+	}
+	for _, templates := range catalog.RegionTemplates {
+		if len(templates) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Merge returns a copy of the catalog with the entries of the overrides laid over it.
+func (catalog *EndpointCatalog) Merge(overrides *EndpointCatalog) *EndpointCatalog {
+	merged := &EndpointCatalog{
+		Templates:       maps.Clone(catalog.Templates),
+		RegionTemplates: map[string]map[string]string{},
+		Regions:         slices.Clone(catalog.Regions),
+	}
+	if merged.Templates == nil {
+		merged.Templates = map[string]string{}
+	}
+	for region, templates := range catalog.RegionTemplates {
+		merged.RegionTemplates[region] = maps.Clone(templates)
+	}
+
+	maps.Copy(merged.Templates, overrides.Templates)
+	for region, templates := range overrides.RegionTemplates {
+		if merged.RegionTemplates[region] == nil {
+			merged.RegionTemplates[region] = map[string]string{}
+		}
+		maps.Copy(merged.RegionTemplates[region], templates)
+	}
+	for _, region := range overrides.Regions {
+		if !slices.Contains(merged.Regions, region) {
+			merged.Regions = append(merged.Regions, region)
+		}
+	}
+	slices.Sort(merged.Regions)
+	return merged
+}
+
+// EndpointResolver : Builds service URLs from a region, an instance ID and an endpoint type.
+type EndpointResolver struct {
+	Catalog *EndpointCatalog
+}
+
+// NewEndpointResolver : constructs an EndpointResolver for the catalog, or for the DefaultEndpointCatalog when nil.
+func NewEndpointResolver(catalog *EndpointCatalog) *EndpointResolver {
+	if catalog == nil {
+		catalog = DefaultEndpointCatalog
+	}
+	return &EndpointResolver{Catalog: catalog}
+}
+
+// ResolveServiceURL returns the service URL of an instance. The endpoint type defaults to EndpointTypePublicConst.
+func (resolver *EndpointResolver) ResolveServiceURL(region string, instanceID string, endpointType string) (serviceURL string, err error) {
+	if endpointType == "" {
+		endpointType = EndpointTypePublicConst
+	}
+	endpointType = strings.ToLower(endpointType)
+	if region == "" || instanceID == "" {
+		err = core.SDKErrorf(nil, "the region and instance ID are required to resolve the service URL", "missing-endpoint-param", common.GetComponentInfo())
+		return
+	}
+
+	catalog := resolver.Catalog
+	if len(catalog.Regions) > 0 && !slices.Contains(catalog.Regions, region) {
+		err = core.SDKErrorf(nil, fmt.Sprintf("unknown region %q; known regions are %s", region, strings.Join(catalog.Regions, ", ")), "unknown-region", common.GetComponentInfo())
+		return
+	}
+	template, ok := catalog.RegionTemplates[region][endpointType]
+	if !ok {
+		template, ok = catalog.Templates[endpointType]
+	}
+	if !ok {
+		err = core.SDKErrorf(nil, fmt.Sprintf("no %q endpoint is available in region %q; configure the endpoint templates with an endpoint catalog", endpointType, region), "unknown-endpoint-type", common.GetComponentInfo())
+		return
+	}
+
+	serviceURL = strings.NewReplacer("{region}", region, "{instance_id}", instanceID).Replace(template)
+	return
+}
+
+// configureEndpoint sets the service URL from the region, instance ID, endpoint type and endpoint catalog
+// properties of the external configuration, when it includes a region but no URL. It fails when no endpoint catalog
+// has URL templates.
+func (sdsaas *SdsaasV2) configureEndpoint(serviceName string) (err error) {
+	props, err := core.GetServiceProperties(serviceName)
+	if err != nil || props[core.PROPNAME_SVC_URL] != "" || props[PropertyRegion] == "" {
+		return
+	}
+
+	catalog := DefaultEndpointCatalog
+	if path := props[PropertyEndpointCatalog]; path != "" {
+		catalog, err = LoadEndpointCatalog(path)
+		if err != nil {
+			return
+		}
+	}
+	if catalog.isEmpty() {
+		err = core.SDKErrorf(nil, fmt.Sprintf("no endpoint catalog is configured to resolve the service URL of region %q; set the %s_%s property to a catalog file, or set the service URL",
+			props[PropertyRegion], strings.ToUpper(serviceName), PropertyEndpointCatalog), "missing-endpoint-catalog", common.GetComponentInfo())
+		return
+	}
+	serviceURL, err := NewEndpointResolver(catalog).ResolveServiceURL(props[PropertyRegion], props[PropertyInstanceID], props[PropertyEndpointType])
+	if err != nil {
+		return
+	}
+	err = sdsaas.Service.SetServiceURL(serviceURL)
+	return
+}
@@ -0,0 +1,161 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`EndpointResolver`, func() {
+	writeCatalog := func(data string) string {
+		path := filepath.Join(GinkgoT().TempDir(), "endpoints.json")
+		Expect(os.WriteFile(path, []byte(data), 0600)).To(Succeed())
+		return path
+	}
+
+	It(`Resolves URLs from a catalog`, func() {
+		resolver := sdsaasv2.NewEndpointResolver(&sdsaasv2.EndpointCatalog{
+			Templates: map[string]string{
+				sdsaasv2.EndpointTypePublicConst:  "https://{instance_id}.{region}.example.com",
+				sdsaasv2.EndpointTypePrivateConst: "https://{instance_id}.private.{region}.example.com",
+			},
+		})
+		serviceURL, err := resolver.ResolveServiceURL("us-south", "abc123", "")
+		Expect(err).To(BeNil())
+		Expect(serviceURL).To(Equal("https://abc123.us-south.example.com"))
+
+		serviceURL, err = resolver.ResolveServiceURL("eu-de", "abc123", "PRIVATE")
+		Expect(err).To(BeNil())
+		Expect(serviceURL).To(Equal("https://abc123.private.eu-de.example.com"))
+	})
+	It(`Has no built-in endpoints`, func() {
+		_, err := sdsaasv2.NewEndpointResolver(nil).ResolveServiceURL("us-south", "abc123", "")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("endpoint catalog"))
+	})
+	It(`Rejects unknown regions, endpoint types and missing parameters`, func() {
+		resolver := sdsaasv2.NewEndpointResolver(&sdsaasv2.EndpointCatalog{
+			Templates: map[string]string{sdsaasv2.EndpointTypePublicConst: "https://{instance_id}.{region}.example.com"},
+			Regions:   []string{"us-south"},
+		})
+		_, err := resolver.ResolveServiceURL("mars-1", "abc123", "")
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("unknown region"))
+		_, err = resolver.ResolveServiceURL("us-south", "abc123", "satellite")
+		Expect(err).ToNot(BeNil())
+		_, err = resolver.ResolveServiceURL("us-south", "", "")
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Merges catalogs and loads them from files`, func() {
+		base := &sdsaasv2.EndpointCatalog{
+			Templates: map[string]string{sdsaasv2.EndpointTypeDirectConst: "https://{instance_id}.direct.{region}.example.com"},
+			Regions:   []string{"us-south"},
+		}
+		overrides, err := sdsaasv2.LoadEndpointCatalog(writeCatalog(`{
+			"regions": ["lab-1"],
+			"templates": {"private": "https://{region}.internal.example.com/{instance_id}"},
+			"region_templates": {"lab-1": {"public": "http://localhost:8080/{instance_id}"}}
+		}`))
+		Expect(err).To(BeNil())
+		resolver := sdsaasv2.NewEndpointResolver(base.Merge(overrides))
+
+		serviceURL, err := resolver.ResolveServiceURL("lab-1", "abc123", "")
+		Expect(err).To(BeNil())
+		Expect(serviceURL).To(Equal("http://localhost:8080/abc123"))
+		serviceURL, err = resolver.ResolveServiceURL("us-south", "abc123", "private")
+		Expect(err).To(BeNil())
+		Expect(serviceURL).To(Equal("https://us-south.internal.example.com/abc123"))
+		serviceURL, err = resolver.ResolveServiceURL("us-south", "abc123", "direct")
+		Expect(err).To(BeNil())
+		Expect(serviceURL).To(Equal("https://abc123.direct.us-south.example.com"))
+
+		// The catalogs that were merged are left unchanged.
+		Expect(base.Regions).ToNot(ContainElement("lab-1"))
+		Expect(sdsaasv2.DefaultEndpointCatalog.Regions).To(BeEmpty())
+
+		_, err = sdsaasv2.LoadEndpointCatalog(writeCatalog(`not json`))
+		Expect(err).ToNot(BeNil())
+	})
+
+	Describe(`External configuration`, func() {
+		testEnvironment := map[string]string{
+			"SDSAAS_AUTH_TYPE":     "noauth",
+			"SDSAAS_REGION":        "us-east",
+			"SDSAAS_INSTANCE_ID":   "abc123",
+			"SDSAAS_ENDPOINT_TYPE": "private",
+		}
+		// Other specs set some of these variables while the suite is being built, so they are restored afterwards.
+		savedEnvironment := map[string]*string{}
+		BeforeEach(func() {
+			for _, name := range []string{"SDSAAS_URL", "SDSAAS_AUTH_TYPE", "SDSAAS_REGION", "SDSAAS_INSTANCE_ID", "SDSAAS_ENDPOINT_TYPE", "SDSAAS_ENDPOINT_CATALOG"} {
+				savedEnvironment[name] = nil
+				if value, ok := os.LookupEnv(name); ok {
+					savedEnvironment[name] = &value
+				}
+				os.Unsetenv(name)
+			}
+		})
+		AfterEach(func() {
+			for name, value := range savedEnvironment {
+				if value == nil {
+					os.Unsetenv(name)
+				} else {
+					os.Setenv(name, *value)
+				}
+			}
+		})
+
+		It(`Requires an endpoint catalog to resolve the service URL`, func() {
+			SetTestEnvironment(testEnvironment)
+			_, err := sdsaasv2.NewSdsaasV2UsingExternalConfig(&sdsaasv2.SdsaasV2Options{})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("set the SDSAAS_ENDPOINT_CATALOG property to a catalog file"))
+		})
+		It(`Uses the catalog file property`, func() {
+			SetTestEnvironment(testEnvironment)
+			os.Setenv("SDSAAS_ENDPOINT_CATALOG", writeCatalog(`{"templates": {"private": "https://{instance_id}.example.com"}}`))
+			sdsaasService, err := sdsaasv2.NewSdsaasV2UsingExternalConfig(&sdsaasv2.SdsaasV2Options{})
+			Expect(err).To(BeNil())
+			Expect(sdsaasService.GetServiceURL()).To(Equal("https://abc123.example.com"))
+		})
+		It(`Prefers an explicit URL`, func() {
+			SetTestEnvironment(testEnvironment)
+			os.Setenv("SDSAAS_URL", "https://sdsaasv2/api")
+			sdsaasService, err := sdsaasv2.NewSdsaasV2UsingExternalConfig(&sdsaasv2.SdsaasV2Options{})
+			Expect(err).To(BeNil())
+			Expect(sdsaasService.GetServiceURL()).To(Equal("https://sdsaasv2/api"))
+		})
+		It(`Does not resolve the service URL when the options have one`, func() {
+			SetTestEnvironment(map[string]string{"SDSAAS_AUTH_TYPE": "noauth", "SDSAAS_REGION": "us-east"})
+			sdsaasService, err := sdsaasv2.NewSdsaasV2UsingExternalConfig(&sdsaasv2.SdsaasV2Options{URL: "https://explicit.example"})
+			Expect(err).To(BeNil())
+			Expect(sdsaasService.GetServiceURL()).To(Equal("https://explicit.example"))
+		})
+		It(`Fails on an unknown region`, func() {
+			SetTestEnvironment(testEnvironment)
+			os.Setenv("SDSAAS_REGION", "mars-1")
+			os.Setenv("SDSAAS_ENDPOINT_CATALOG", writeCatalog(`{"regions": ["us-east"], "templates": {"private": "https://{instance_id}.example.com"}}`))
+			_, err := sdsaasv2.NewSdsaasV2UsingExternalConfig(&sdsaasv2.SdsaasV2Options{})
+			Expect(err).ToNot(BeNil())
+		})
+	})
+})
@@ -0,0 +1,324 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+)
+
+// DefaultFleetMaxConcurrency is the number of instances a Fleet calls at once when FleetOptions.MaxConcurrency is
+// not set.
+const DefaultFleetMaxConcurrency = 8
+
+// ErrFleetInstanceNotFound is returned for an instance name that is not part of the fleet.
+var ErrFleetInstanceNotFound = errors.New("fleet instance not found")
+
+// FleetOptions : Options for a Fleet.
+type FleetOptions struct {
+	// How long an operation may take on a single instance. No limit when 0.
+	Timeout time.Duration
+
+	// The number of instances called at once. Defaults to DefaultFleetMaxConcurrency.
+	MaxConcurrency int
+}
+
+// FleetInstance : A named SDSaaS instance of a Fleet.
+type FleetInstance struct {
+	// The name of the instance, unique within the fleet.
+	Name string
+
+	// The client of the instance.
+	Client *SdsaasV2
+
+	// Labels used to select instances, e.g. {"env": "prod"}.
+	Labels map[string]string
+}
+
+// FleetResult : The outcome of an operation on one instance of a Fleet.
+type FleetResult[T any] struct {
+	// The name of the instance.
+	Instance string
+
+	// The result of the operation; the zero value if it failed.
+	Result T
+
+	// The error returned by the operation on this instance.
+	Error error
+
+	// How long the operation took on this instance.
+	Duration time.Duration
+}
+
+// FleetError : The errors of the instances an operation failed on.
+type FleetError struct {
+	// The error of each failed instance, by instance name.
+	Errors map[string]error
+}
+
+// Error returns the errors of the failed instances, in instance name order.
+func (fleetError *FleetError) Error() string {
+	names := slices.Sorted(maps.Keys(fleetError.Errors))
+	messages := make([]string, 0, len(names))
+	for _, name := range names {
+		messages = append(messages, fmt.Sprintf("%s: %s", name, fleetError.Errors[name]))
+	}
+	return fmt.Sprintf("operation failed on %d instance(s): %s", len(names), strings.Join(messages, "; "))
+}
+
+// Unwrap returns the errors of the failed instances, so that errors.Is and errors.As can match any of them.
+func (fleetError *FleetError) Unwrap() []error {
+	return slices.Collect(maps.Values(fleetError.Errors))
+}
+
+// Fleet : A set of named SdsaasV2 clients, each with its own URL and authenticator, that operations can be run
+// against concurrently. A failure or timeout on one instance does not affect the others.
+type Fleet struct {
+	options   FleetOptions
+	mutex     sync.RWMutex
+	instances map[string]*FleetInstance
+}
+
+// NewFleet : constructs an empty Fleet.
+func NewFleet(options *FleetOptions) *Fleet {
+	fleet := &Fleet{instances: map[string]*FleetInstance{}}
+	if options != nil {
+		fleet.options = *options
+	}
+	if fleet.options.MaxConcurrency <= 0 {
+		fleet.options.MaxConcurrency = DefaultFleetMaxConcurrency
+	}
+	return fleet
+}
+
+// AddInstance adds a client to the fleet under a unique name.
+func (fleet *Fleet) AddInstance(name string, client *SdsaasV2, labels map[string]string) error {
+	if name == "" || client == nil {
+		return core.SDKErrorf(nil, "the instance name and client are required", "invalid-fleet-instance", common.GetComponentInfo())
+	}
+	fleet.mutex.Lock()
+	defer fleet.mutex.Unlock()
+	if _, exists := fleet.instances[name]; exists {
+		return core.SDKErrorf(nil, fmt.Sprintf("the fleet already has an instance named %q", name), "duplicate-fleet-instance", common.GetComponentInfo())
+	}
+	fleet.instances[name] = &FleetInstance{Name: name, Client: client, Labels: maps.Clone(labels)}
+	return nil
+}
+
+// AddInstanceWithOptions constructs a client from the options and adds it to the fleet under a unique name.
+func (fleet *Fleet) AddInstanceWithOptions(name string, options *SdsaasV2Options, labels map[string]string) error {
+	client, err := NewSdsaasV2(options)
+	if err != nil {
+		return core.RepurposeSDKProblem(err, "new-client-error")
+	}
+	return fleet.AddInstance(name, client, labels)
+}
+
+// RemoveInstance removes an instance from the fleet.
+func (fleet *Fleet) RemoveInstance(name string) {
+	fleet.mutex.Lock()
+	defer fleet.mutex.Unlock()
+	delete(fleet.instances, name)
+}
+
+// GetInstance returns the instance with the name, or nil.
+func (fleet *Fleet) GetInstance(name string) *FleetInstance {
+	fleet.mutex.RLock()
+	defer fleet.mutex.RUnlock()
+	return fleet.instances[name]
+}
+
+// InstanceNames returns the names of all instances, sorted.
+func (fleet *Fleet) InstanceNames() []string {
+	fleet.mutex.RLock()
+	defer fleet.mutex.RUnlock()
+	return slices.Sorted(maps.Keys(fleet.instances))
+}
+
+// SelectInstances returns the sorted names of the instances that have all of the labels.
+func (fleet *Fleet) SelectInstances(labels map[string]string) (names []string) {
+	fleet.mutex.RLock()
+	defer fleet.mutex.RUnlock()
+	for name, instance := range fleet.instances {
+		selected := true
+		for key, value := range labels {
+			if instance.Labels[key] != value {
+				selected = false
+				break
+			}
+		}
+		if selected {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return
+}
+
+// RunOnFleet calls the operation concurrently on the named instances, or on every instance when names is nil, and
+// returns one result per instance in the order of the names. Each call gets its own context, limited by
+// FleetOptions.Timeout; a panic in one call is returned as that instance's error.
+func RunOnFleet[T any](ctx context.Context, fleet *Fleet, names []string, operation func(ctx context.Context, instance *FleetInstance) (T, error)) []FleetResult[T] {
+	if names == nil {
+		names = fleet.InstanceNames()
+	}
+	results := make([]FleetResult[T], len(names))
+	semaphore := make(chan struct{}, fleet.options.MaxConcurrency)
+	var waitGroup sync.WaitGroup
+	for i, name := range names {
+		results[i].Instance = name
+		instance := fleet.GetInstance(name)
+		if instance == nil {
+			results[i].Error = core.SDKErrorf(ErrFleetInstanceNotFound, fmt.Sprintf("the fleet has no instance named %q", name), "fleet-instance-not-found", common.GetComponentInfo())
+			continue
+		}
+		waitGroup.Add(1)
+		go func(result *FleetResult[T]) {
+			defer waitGroup.Done()
+			select {
+			case semaphore <- struct{}{}:
+				defer func() { <-semaphore }()
+			case <-ctx.Done():
+				result.Error = ctx.Err()
+				return
+			}
+			result.Result, result.Duration, result.Error = runOnInstance(ctx, fleet.options.Timeout, instance, operation)
+		}(&results[i])
+	}
+	waitGroup.Wait()
+	return results
+}
+
+func runOnInstance[T any](ctx context.Context, timeout time.Duration, instance *FleetInstance, operation func(ctx context.Context, instance *FleetInstance) (T, error)) (result T, duration time.Duration, err error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	started := time.Now()
+	defer func() {
+		duration = time.Since(started)
+		if recovered := recover(); recovered != nil {
+			err = core.SDKErrorf(nil, fmt.Sprintf("operation panicked: %v", recovered), "fleet-operation-panic", common.GetComponentInfo())
+		}
+	}()
+	result, err = operation(ctx, instance)
+	return
+}
+
+// Do runs a bulk action concurrently on the named instances, or on every instance when names is nil. It returns a
+// *FleetError holding the error of every instance the action failed on, or nil if it succeeded everywhere.
+func (fleet *Fleet) Do(ctx context.Context, names []string, action func(ctx context.Context, instance *FleetInstance) error) error {
+	results := RunOnFleet(ctx, fleet, names, func(ctx context.Context, instance *FleetInstance) (struct{}, error) {
+		return struct{}{}, action(ctx, instance)
+	})
+	return FleetErrors(results)
+}
+
+// FleetErrors returns a *FleetError holding the errors of the failed results, or nil if none failed.
+func FleetErrors[T any](results []FleetResult[T]) error {
+	errs := map[string]error{}
+	for _, result := range results {
+		if result.Error != nil {
+			errs[result.Instance] = result.Error
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return &FleetError{Errors: errs}
+}
+
+// ListVolumes lists every volume of every instance.
+func (fleet *Fleet) ListVolumes(ctx context.Context, listVolumesOptions *ListVolumesOptions) []FleetResult[[]Volume] {
+	if listVolumesOptions == nil {
+		listVolumesOptions = &ListVolumesOptions{}
+	}
+	return RunOnFleet(ctx, fleet, nil, func(ctx context.Context, instance *FleetInstance) ([]Volume, error) {
+		pager, err := instance.Client.NewVolumesPager(listVolumesOptions)
+		if err != nil {
+			return nil, err
+		}
+		return pager.GetAllWithContext(ctx)
+	})
+}
+
+// ListHosts lists every host of every instance.
+func (fleet *Fleet) ListHosts(ctx context.Context, listHostsOptions *ListHostsOptions) []FleetResult[[]Host] {
+	if listHostsOptions == nil {
+		listHostsOptions = &ListHostsOptions{}
+	}
+	return RunOnFleet(ctx, fleet, nil, func(ctx context.Context, instance *FleetInstance) ([]Host, error) {
+		pager, err := instance.Client.NewHostsPager(listHostsOptions)
+		if err != nil {
+			return nil, err
+		}
+		return pager.GetAllWithContext(ctx)
+	})
+}
+
+// ListSnapshots lists every snapshot of every instance.
+func (fleet *Fleet) ListSnapshots(ctx context.Context, listSnapshotsOptions *ListSnapshotsOptions) []FleetResult[[]Snapshot] {
+	if listSnapshotsOptions == nil {
+		listSnapshotsOptions = &ListSnapshotsOptions{}
+	}
+	return RunOnFleet(ctx, fleet, nil, func(ctx context.Context, instance *FleetInstance) ([]Snapshot, error) {
+		pager, err := instance.Client.NewSnapshotsPager(listSnapshotsOptions)
+		if err != nil {
+			return nil, err
+		}
+		return pager.GetAllWithContext(ctx)
+	})
+}
+
+// ListHmacCredentials lists the HMAC credential access keys of every instance.
+func (fleet *Fleet) ListHmacCredentials(ctx context.Context) []FleetResult[[]string] {
+	return RunOnFleet(ctx, fleet, nil, func(ctx context.Context, instance *FleetInstance) ([]string, error) {
+		result, _, err := instance.Client.ListHmacCredentialsWithContext(ctx, instance.Client.NewListHmacCredentialsOptions())
+		if err != nil {
+			return nil, err
+		}
+		if result == nil {
+			return nil, core.SDKErrorf(nil, "the response of ListHmacCredentials has no result", "missing-result", common.GetComponentInfo())
+		}
+		return result.S3Credentials, nil
+	})
+}
+
+// ListCertificates lists the certificates configured on every instance.
+func (fleet *Fleet) ListCertificates(ctx context.Context) []FleetResult[[]string] {
+	return RunOnFleet(ctx, fleet, nil, func(ctx context.Context, instance *FleetInstance) ([]string, error) {
+		result, _, err := instance.Client.ListCertificatesWithContext(ctx, instance.Client.NewListCertificatesOptions())
+		if err != nil {
+			return nil, err
+		}
+		if result == nil {
+			return nil, core.SDKErrorf(nil, "the response of ListCertificates has no result", "missing-result", common.GetComponentInfo())
+		}
+		return result.Certificates, nil
+	})
+}
@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Fleet`, func() {
+	var (
+		servers []*httptest.Server
+		fleet   *sdsaasv2.Fleet
+		mutex   sync.Mutex
+		deleted []string
+	)
+	ctx := context.Background()
+
+	// newInstanceServer serves the volumes of one instance; a negative status fails every request and a delay
+	// slows every request down.
+	newInstanceServer := func(name string, status int, delay time.Duration) *httptest.Server {
+		server := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			select {
+			case <-time.After(delay):
+			case <-req.Context().Done():
+				return
+			}
+			res.Header().Set("Content-type", "application/json")
+			if status != 0 {
+				res.WriteHeader(status)
+				fmt.Fprint(res, `{"errors": [{"code": "internal_error", "message": "down"}]}`)
+				return
+			}
+			switch {
+			case req.URL.Path == "/s3_credentials" || req.URL.Path == "/certificates":
+				// The lists of access keys and certificates come back without a body.
+				res.WriteHeader(204)
+			case req.Method == "GET":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"volumes": [{"id": "%s-vol-1", "name": "data"}, {"id": "%s-vol-2", "name": "logs"}]}`, name, name)
+			case req.Method == "DELETE":
+				mutex.Lock()
+				deleted = append(deleted, name+req.URL.Path)
+				mutex.Unlock()
+				res.WriteHeader(202)
+			}
+		}))
+		servers = append(servers, server)
+		return server
+	}
+
+	BeforeEach(func() {
+		servers = nil
+		deleted = nil
+		fleet = sdsaasv2.NewFleet(&sdsaasv2.FleetOptions{Timeout: 200 * time.Millisecond})
+		for _, instance := range []struct {
+			name   string
+			env    string
+			status int
+			delay  time.Duration
+		}{
+			{"dev", "dev", 0, 0},
+			{"prod-eu", "prod", 0, 0},
+			{"prod-us", "prod", 500, 0},
+			{"staging", "staging", 0, 5 * time.Second},
+		} {
+			server := newInstanceServer(instance.name, instance.status, instance.delay)
+			Expect(fleet.AddInstanceWithOptions(instance.name, &sdsaasv2.SdsaasV2Options{
+				URL:           server.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			}, map[string]string{"env": instance.env})).To(Succeed())
+		}
+	})
+	AfterEach(func() {
+		for _, server := range servers {
+			server.CloseClientConnections()
+			server.Close()
+		}
+	})
+
+	It(`Manages its instances`, func() {
+		Expect(fleet.InstanceNames()).To(Equal([]string{"dev", "prod-eu", "prod-us", "staging"}))
+		Expect(fleet.SelectInstances(map[string]string{"env": "prod"})).To(Equal([]string{"prod-eu", "prod-us"}))
+		Expect(fleet.AddInstance("dev", fleet.GetInstance("prod-eu").Client, nil)).ToNot(Succeed())
+		Expect(fleet.AddInstance("", fleet.GetInstance("prod-eu").Client, nil)).ToNot(Succeed())
+
+		fleet.RemoveInstance("staging")
+		Expect(fleet.GetInstance("staging")).To(BeNil())
+		Expect(fleet.InstanceNames()).To(HaveLen(3))
+	})
+	It(`Lists volumes across instances with per-instance errors`, func() {
+		results := fleet.ListVolumes(ctx, nil)
+		Expect(results).To(HaveLen(4))
+
+		Expect(results[0].Instance).To(Equal("dev"))
+		Expect(results[0].Error).To(BeNil())
+		Expect(results[0].Result).To(HaveLen(2))
+		Expect(*results[0].Result[0].ID).To(Equal("dev-vol-1"))
+		Expect(results[1].Instance).To(Equal("prod-eu"))
+		Expect(*results[1].Result[1].ID).To(Equal("prod-eu-vol-2"))
+
+		Expect(results[2].Instance).To(Equal("prod-us"))
+		Expect(results[2].Error).ToNot(BeNil())
+		Expect(results[2].Result).To(BeNil())
+
+		// The slow instance times out without holding up the others.
+		Expect(results[3].Instance).To(Equal("staging"))
+		Expect(errors.Is(results[3].Error, context.DeadlineExceeded)).To(BeTrue())
+		Expect(results[3].Duration).To(BeNumerically("<", time.Second))
+
+		err := sdsaasv2.FleetErrors(results)
+		var fleetError *sdsaasv2.FleetError
+		Expect(errors.As(err, &fleetError)).To(BeTrue())
+		Expect(fleetError.Errors).To(HaveLen(2))
+		Expect(fleetError.Errors).To(HaveKey("prod-us"))
+		Expect(fleetError.Errors).To(HaveKey("staging"))
+	})
+	It(`Runs bulk actions on a subset of instances`, func() {
+		err := fleet.Do(ctx, fleet.SelectInstances(map[string]string{"env": "prod"}), func(ctx context.Context, instance *sdsaasv2.FleetInstance) error {
+			_, err := instance.Client.DeleteVolumeWithContext(ctx, instance.Client.NewDeleteVolumeOptions(instance.Name+"-vol-1"))
+			return err
+		})
+		Expect(deleted).To(Equal([]string{"prod-eu/volumes/prod-eu-vol-1"}))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(HavePrefix("operation failed on 1 instance(s): prod-us:"))
+
+		err = fleet.Do(ctx, []string{"dev"}, func(ctx context.Context, instance *sdsaasv2.FleetInstance) error {
+			return nil
+		})
+		Expect(err).To(BeNil())
+	})
+	It(`Reports responses without a result`, func() {
+		for _, results := range [][]sdsaasv2.FleetResult[[]string]{fleet.ListHmacCredentials(ctx), fleet.ListCertificates(ctx)} {
+			Expect(results[0].Instance).To(Equal("dev"))
+			Expect(results[0].Error).ToNot(BeNil())
+			Expect(results[0].Error.Error()).To(ContainSubstring("has no result"))
+			Expect(results[0].Error.Error()).ToNot(ContainSubstring("panicked"))
+		}
+	})
+	It(`Isolates unknown instances and panics`, func() {
+		results := sdsaasv2.RunOnFleet(ctx, fleet, []string{"dev", "missing"}, func(ctx context.Context, instance *sdsaasv2.FleetInstance) (string, error) {
+			panic("boom")
+		})
+		Expect(results).To(HaveLen(2))
+		Expect(results[0].Error).ToNot(BeNil())
+		Expect(results[0].Error.Error()).To(ContainSubstring("boom"))
+		Expect(errors.Is(results[1].Error, sdsaasv2.ErrFleetInstanceNotFound)).To(BeTrue())
+	})
+})
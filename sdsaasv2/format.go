@@ -0,0 +1,702 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+	"github.com/go-openapi/strfmt"
+)
+
+// FormatOptions : The options of the human-readable rendering of the models, by FormatModel and RenderTable.
+type FormatOptions struct {
+	// When true, renders every property, including href, resource_type, the items of lists and the additional
+	// properties. Otherwise only the properties useful at a glance are rendered, and lists by their length.
+	Wide bool
+
+	// When true, renders timestamps relative to Now, e.g. "3h ago". Otherwise as RFC 3339.
+	RelativeTime bool
+
+	// The time that relative timestamps are measured from. The current time when zero.
+	Now time.Time
+}
+
+// The value rendered in place of a secret.
+const formatRedacted = "[redacted]"
+
+// The properties that are left out of the compact rendering.
+var formatCompactOmitted = map[string]bool{
+	"href":          true,
+	"resource_type": true,
+}
+
+// The properties that are capacities, in gigabytes.
+var formatCapacities = map[string]bool{
+	"capacity":         true,
+	"minimum_capacity": true,
+	"size":             true,
+}
+
+// FormatModel returns a single-line rendering of a model, e.g.
+// `Volume{id=r134-... name=data status=available capacity=10GB created_at=3h ago}`. Missing properties are left
+// out, pointers are dereferenced, capacities are rendered with units and secrets are redacted. The options are the
+// compact rendering with absolute timestamps when nil.
+func FormatModel(model interface{}, options *FormatOptions) string {
+	if options == nil {
+		options = &FormatOptions{}
+	}
+	value := reflect.ValueOf(model)
+	for value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return "<nil>"
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return fmt.Sprint(model)
+	}
+	return value.Type().Name() + formatStruct(value, options)
+}
+
+// RenderTable writes a table of models, with a header row of their property names and a row per model, e.g. for
+// the Volumes of a VolumeCollection. The items are a slice of models, or of pointers to models. The compact table
+// leaves out href, resource_type and lists; the wide table has every property, with the lengths of the lists.
+func RenderTable(writer io.Writer, items interface{}, options *FormatOptions) (err error) {
+	if options == nil {
+		options = &FormatOptions{}
+	}
+	value := reflect.ValueOf(items)
+	if value.Kind() != reflect.Slice {
+		err = core.SDKErrorf(nil, fmt.Sprintf("the items to render must be a slice of models, not %T", items), "render-table-items", common.GetComponentInfo())
+		return
+	}
+	elemType := value.Type().Elem()
+	if elemType.Kind() == reflect.Ptr {
+		elemType = elemType.Elem()
+	}
+	if elemType.Kind() != reflect.Struct {
+		err = core.SDKErrorf(nil, fmt.Sprintf("the items to render must be a slice of models, not %T", items), "render-table-items", common.GetComponentInfo())
+		return
+	}
+
+	var columns []int
+	var headers []string
+	for i := 0; i < elemType.NumField(); i++ {
+		field := elemType.Field(i)
+		name := formatFieldName(field)
+		if name == "" {
+			continue
+		}
+		if !options.Wide && (formatCompactOmitted[name] || field.Type.Kind() == reflect.Slice) {
+			continue
+		}
+		columns = append(columns, i)
+		headers = append(headers, strings.ToUpper(strings.ReplaceAll(name, "_", " ")))
+	}
+
+	table := tabwriter.NewWriter(writer, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(table, strings.Join(headers, "\t"))
+	for i := 0; i < value.Len(); i++ {
+		item := value.Index(i)
+		if item.Kind() == reflect.Ptr {
+			if item.IsNil() {
+				continue
+			}
+			item = item.Elem()
+		}
+		cells := make([]string, len(columns))
+		for j, column := range columns {
+			cells[j] = formatCell(item.Field(column), formatFieldName(elemType.Field(column)), options)
+		}
+		fmt.Fprintln(table, strings.Join(cells, "\t"))
+	}
+	if err = table.Flush(); err != nil {
+		err = core.SDKErrorf(err, "", "render-table-write", common.GetComponentInfo())
+	}
+	return
+}
+
+// formatState renders a model for the fmt verbs: %v and %s are compact, %+v is wide, %#v is the Go syntax and %q is
+// the quoted compact rendering.
+func formatState(state fmt.State, verb rune, model interface{}) {
+	switch verb {
+	case 'v':
+		if state.Flag('#') {
+			formatGoSyntax(state, model)
+			return
+		}
+		io.WriteString(state, FormatModel(model, &FormatOptions{Wide: state.Flag('+')}))
+	case 's':
+		io.WriteString(state, FormatModel(model, nil))
+	case 'q':
+		io.WriteString(state, strconv.Quote(FormatModel(model, nil)))
+	default:
+		fmt.Fprintf(state, "%%!%c(%s)", verb, reflect.TypeOf(model).String())
+	}
+}
+
+// formatGoSyntax renders a model pointer as %#v would without a Format method. The secrets among the additional
+// properties are redacted; the secret properties of the model are pointers, rendered as addresses.
+func formatGoSyntax(state fmt.State, model interface{}) {
+	value := reflect.ValueOf(model)
+	if value.IsNil() {
+		fmt.Fprintf(state, "(%T)(nil)", model)
+		return
+	}
+	// The struct value, unlike the pointer, has no Format method.
+	copied := reflect.New(value.Elem().Type())
+	copied.Elem().Set(value.Elem())
+	if properties, ok := copied.Interface().(interface {
+		GetProperties() map[string]interface{}
+		SetProperties(map[string]interface{})
+	}); ok {
+		additional := properties.GetProperties()
+		for key := range additional {
+			if isSecretProperty(key) {
+				redacted := make(map[string]interface{}, len(additional))
+				for key, value := range additional {
+					redacted[key] = value
+					if isSecretProperty(key) {
+						redacted[key] = formatRedacted
+					}
+				}
+				properties.SetProperties(redacted)
+				break
+			}
+		}
+	}
+	fmt.Fprintf(state, "&%#v", copied.Elem().Interface())
+}
+
+func formatFieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if !field.IsExported() || name == "-" {
+		return ""
+	}
+	return name
+}
+
+func formatStruct(value reflect.Value, options *FormatOptions) string {
+	valueType := value.Type()
+	var properties []string
+	for i := 0; i < valueType.NumField(); i++ {
+		field := valueType.Field(i)
+		name := formatFieldName(field)
+		if name == "" || (!options.Wide && formatCompactOmitted[name]) {
+			continue
+		}
+		fieldValue := value.Field(i)
+		if isMissing(fieldValue) {
+			continue
+		}
+		properties = append(properties, name+"="+formatValue(fieldValue, name, options))
+	}
+	if options.Wide && value.CanAddr() {
+		if model, ok := value.Addr().Interface().(interface{ GetProperties() map[string]interface{} }); ok {
+			additional := model.GetProperties()
+			keys := make([]string, 0, len(additional))
+			for key := range additional {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				rendered := formatRedacted
+				if !isSecretProperty(key) {
+					rendered = formatQuote(fmt.Sprint(additional[key]))
+				}
+				properties = append(properties, key+"="+rendered)
+			}
+		}
+	}
+	return "{" + strings.Join(properties, " ") + "}"
+}
+
+func formatValue(value reflect.Value, name string, options *FormatOptions) string {
+	if isSecretProperty(name) {
+		return formatRedacted
+	}
+	if value.Kind() == reflect.Ptr {
+		if value.IsNil() {
+			return "<nil>"
+		}
+		if dateTime, ok := value.Interface().(*strfmt.DateTime); ok {
+			return formatQuote(formatTime(time.Time(*dateTime), options))
+		}
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.Struct:
+		if !options.Wide {
+			if reference, ok := formatReference(value); ok {
+				return reference
+			}
+		}
+		return formatStruct(value, options)
+	case reflect.Slice:
+		if !options.Wide {
+			return fmt.Sprintf("[%d]", value.Len())
+		}
+		items := make([]string, value.Len())
+		for i := range items {
+			items[i] = formatValue(value.Index(i), name, options)
+		}
+		return "[" + strings.Join(items, " ") + "]"
+	case reflect.Int, reflect.Int32, reflect.Int64:
+		if formatCapacities[name] {
+			return formatCapacity(value.Int())
+		}
+		if name == "bandwidth" {
+			return strconv.FormatInt(value.Int(), 10) + "Mbps"
+		}
+		return strconv.FormatInt(value.Int(), 10)
+	case reflect.String:
+		return formatQuote(value.String())
+	}
+	return fmt.Sprint(value.Interface())
+}
+
+// formatCell renders a property in a table cell, like the compact rendering, with "-" when it is missing.
+func formatCell(value reflect.Value, name string, options *FormatOptions) string {
+	if isMissing(value) {
+		return "-"
+	}
+	if value.Kind() == reflect.Slice {
+		return strconv.Itoa(value.Len())
+	}
+	cellOptions := *options
+	cellOptions.Wide = false
+	rendered := formatValue(value, name, &cellOptions)
+	if unquoted, err := strconv.Unquote(rendered); err == nil {
+		rendered = unquoted
+	}
+	return rendered
+}
+
+// formatReference renders a model held by another model by its name or ID, e.g. the source_volume of a Snapshot.
+func formatReference(value reflect.Value) (string, bool) {
+	for _, fieldName := range []string{"Name", "ID"} {
+		field := value.FieldByName(fieldName)
+		if field.IsValid() && field.Kind() == reflect.Ptr && !field.IsNil() && field.Elem().Kind() == reflect.String {
+			return formatQuote(field.Elem().String()), true
+		}
+	}
+	return "", false
+}
+
+// formatCapacity renders a capacity in gigabytes, e.g. 10GB, 2TB or 1.5TB.
+func formatCapacity(gigabytes int64) string {
+	if gigabytes < 1024 && gigabytes > -1024 {
+		return strconv.FormatInt(gigabytes, 10) + "GB"
+	}
+	terabytes := math.Round(float64(gigabytes)/1024*10) / 10
+	return strconv.FormatFloat(terabytes, 'f', -1, 64) + "TB"
+}
+
+// formatTime renders a timestamp as RFC 3339 or, with RelativeTime, e.g. "3h ago" or "in 2d".
+func formatTime(timestamp time.Time, options *FormatOptions) string {
+	if !options.RelativeTime {
+		return timestamp.UTC().Format(time.RFC3339)
+	}
+	now := options.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+	elapsed := now.Sub(timestamp)
+	if elapsed > -time.Second && elapsed < time.Second {
+		return "just now"
+	}
+	if elapsed < 0 {
+		return "in " + formatDuration(-elapsed)
+	}
+	return formatDuration(elapsed) + " ago"
+}
+
+// formatDuration renders a duration in its largest whole unit, e.g. 45s, 12m, 3h or 5d.
+func formatDuration(duration time.Duration) string {
+	switch {
+	case duration >= 24*time.Hour:
+		return fmt.Sprintf("%dd", duration/(24*time.Hour))
+	case duration >= time.Hour:
+		return fmt.Sprintf("%dh", duration/time.Hour)
+	case duration >= time.Minute:
+		return fmt.Sprintf("%dm", duration/time.Minute)
+	}
+	return fmt.Sprintf("%ds", duration/time.Second)
+}
+
+// formatQuote quotes a rendered value when it would otherwise be ambiguous in a line of key=value pairs.
+func formatQuote(rendered string) string {
+	if rendered == "" || strings.ContainsAny(rendered, " \t\n\"=") {
+		return strconv.Quote(rendered)
+	}
+	return rendered
+}
+
+// isSecretProperty reports whether a property holds a secret, e.g. the secret_key of an AccessKeyResponse or the
+// psk of a host.
+func isSecretProperty(name string) bool {
+	name = strings.ToLower(name)
+	if name == "psk" || strings.HasSuffix(name, "_psk") {
+		return true
+	}
+	for _, secret := range []string{"secret", "password", "token", "private_key"} {
+		if strings.Contains(name, secret) {
+			return true
+		}
+	}
+	return false
+}
+
+// String returns the compact rendering of the AccessKeyResponse, see FormatModel.
+func (accessKeyResponse *AccessKeyResponse) String() string {
+	return FormatModel(accessKeyResponse, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the AccessKeyResponse, %+v the wide one and %#v the Go syntax.
+func (accessKeyResponse *AccessKeyResponse) Format(state fmt.State, verb rune) {
+	formatState(state, verb, accessKeyResponse)
+}
+
+// String returns the compact rendering of the CertListResponse, see FormatModel.
+func (certListResponse *CertListResponse) String() string {
+	return FormatModel(certListResponse, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the CertListResponse, %+v the wide one and %#v the Go syntax.
+func (certListResponse *CertListResponse) Format(state fmt.State, verb rune) {
+	formatState(state, verb, certListResponse)
+}
+
+// String returns the compact rendering of the CertResponse, see FormatModel.
+func (certResponse *CertResponse) String() string {
+	return FormatModel(certResponse, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the CertResponse, %+v the wide one and %#v the Go syntax.
+func (certResponse *CertResponse) Format(state fmt.State, verb rune) {
+	formatState(state, verb, certResponse)
+}
+
+// String returns the compact rendering of the ErrorObject, see FormatModel.
+func (errorObject *ErrorObject) String() string {
+	return FormatModel(errorObject, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the ErrorObject, %+v the wide one and %#v the Go syntax.
+func (errorObject *ErrorObject) Format(state fmt.State, verb rune) {
+	formatState(state, verb, errorObject)
+}
+
+// String returns the compact rendering of the Gateway, see FormatModel.
+func (gateway *Gateway) String() string {
+	return FormatModel(gateway, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the Gateway, %+v the wide one and %#v the Go syntax.
+func (gateway *Gateway) Format(state fmt.State, verb rune) {
+	formatState(state, verb, gateway)
+}
+
+// String returns the compact rendering of the Host, see FormatModel.
+func (host *Host) String() string {
+	return FormatModel(host, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the Host, %+v the wide one and %#v the Go syntax.
+func (host *Host) Format(state fmt.State, verb rune) {
+	formatState(state, verb, host)
+}
+
+// String returns the compact rendering of the HostCollection, see FormatModel.
+func (hostCollection *HostCollection) String() string {
+	return FormatModel(hostCollection, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the HostCollection, %+v the wide one and %#v the Go syntax.
+func (hostCollection *HostCollection) Format(state fmt.State, verb rune) {
+	formatState(state, verb, hostCollection)
+}
+
+// String returns the compact rendering of the HostPatch, see FormatModel.
+func (hostPatch *HostPatch) String() string {
+	return FormatModel(hostPatch, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the HostPatch, %+v the wide one and %#v the Go syntax.
+func (hostPatch *HostPatch) Format(state fmt.State, verb rune) {
+	formatState(state, verb, hostPatch)
+}
+
+// String returns the compact rendering of the HostReference, see FormatModel.
+func (hostReference *HostReference) String() string {
+	return FormatModel(hostReference, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the HostReference, %+v the wide one and %#v the Go syntax.
+func (hostReference *HostReference) Format(state fmt.State, verb rune) {
+	formatState(state, verb, hostReference)
+}
+
+// String returns the compact rendering of the HostSummary, see FormatModel.
+func (hostSummary *HostSummary) String() string {
+	return FormatModel(hostSummary, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the HostSummary, %+v the wide one and %#v the Go syntax.
+func (hostSummary *HostSummary) Format(state fmt.State, verb rune) {
+	formatState(state, verb, hostSummary)
+}
+
+// String returns the compact rendering of the Namespace, see FormatModel.
+func (namespace *Namespace) String() string {
+	return FormatModel(namespace, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the Namespace, %+v the wide one and %#v the Go syntax.
+func (namespace *Namespace) Format(state fmt.State, verb rune) {
+	formatState(state, verb, namespace)
+}
+
+// String returns the compact rendering of the PageLink, see FormatModel.
+func (pageLink *PageLink) String() string {
+	return FormatModel(pageLink, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the PageLink, %+v the wide one and %#v the Go syntax.
+func (pageLink *PageLink) Format(state fmt.State, verb rune) {
+	formatState(state, verb, pageLink)
+}
+
+// String returns the compact rendering of the Snapshot, see FormatModel.
+func (snapshot *Snapshot) String() string {
+	return FormatModel(snapshot, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the Snapshot, %+v the wide one and %#v the Go syntax.
+func (snapshot *Snapshot) Format(state fmt.State, verb rune) {
+	formatState(state, verb, snapshot)
+}
+
+// String returns the compact rendering of the SnapshotCollection, see FormatModel.
+func (snapshotCollection *SnapshotCollection) String() string {
+	return FormatModel(snapshotCollection, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the SnapshotCollection, %+v the wide one and %#v the Go syntax.
+func (snapshotCollection *SnapshotCollection) Format(state fmt.State, verb rune) {
+	formatState(state, verb, snapshotCollection)
+}
+
+// String returns the compact rendering of the SnapshotPatch, see FormatModel.
+func (snapshotPatch *SnapshotPatch) String() string {
+	return FormatModel(snapshotPatch, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the SnapshotPatch, %+v the wide one and %#v the Go syntax.
+func (snapshotPatch *SnapshotPatch) Format(state fmt.State, verb rune) {
+	formatState(state, verb, snapshotPatch)
+}
+
+// String returns the compact rendering of the SourceSnapshot, see FormatModel.
+func (sourceSnapshot *SourceSnapshot) String() string {
+	return FormatModel(sourceSnapshot, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the SourceSnapshot, %+v the wide one and %#v the Go syntax.
+func (sourceSnapshot *SourceSnapshot) Format(state fmt.State, verb rune) {
+	formatState(state, verb, sourceSnapshot)
+}
+
+// String returns the compact rendering of the SourceVolume, see FormatModel.
+func (sourceVolume *SourceVolume) String() string {
+	return FormatModel(sourceVolume, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the SourceVolume, %+v the wide one and %#v the Go syntax.
+func (sourceVolume *SourceVolume) Format(state fmt.State, verb rune) {
+	formatState(state, verb, sourceVolume)
+}
+
+// String returns the compact rendering of the SourceVolumeGroupSnapshot, see FormatModel.
+func (sourceVolumeGroupSnapshot *SourceVolumeGroupSnapshot) String() string {
+	return FormatModel(sourceVolumeGroupSnapshot, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the SourceVolumeGroupSnapshot, %+v the wide one and %#v the Go syntax.
+func (sourceVolumeGroupSnapshot *SourceVolumeGroupSnapshot) Format(state fmt.State, verb rune) {
+	formatState(state, verb, sourceVolumeGroupSnapshot)
+}
+
+// String returns the compact rendering of the SourceVolumeGroupSnapshotVolume, see FormatModel.
+func (sourceVolumeGroupSnapshotVolume *SourceVolumeGroupSnapshotVolume) String() string {
+	return FormatModel(sourceVolumeGroupSnapshotVolume, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the SourceVolumeGroupSnapshotVolume, %+v the wide one and %#v the Go syntax.
+func (sourceVolumeGroupSnapshotVolume *SourceVolumeGroupSnapshotVolume) Format(state fmt.State, verb rune) {
+	formatState(state, verb, sourceVolumeGroupSnapshotVolume)
+}
+
+// String returns the compact rendering of the SourceVolumePrototype, see FormatModel.
+func (sourceVolumePrototype *SourceVolumePrototype) String() string {
+	return FormatModel(sourceVolumePrototype, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the SourceVolumePrototype, %+v the wide one and %#v the Go syntax.
+func (sourceVolumePrototype *SourceVolumePrototype) Format(state fmt.State, verb rune) {
+	formatState(state, verb, sourceVolumePrototype)
+}
+
+// String returns the compact rendering of the StatusResponse, see FormatModel.
+func (statusResponse *StatusResponse) String() string {
+	return FormatModel(statusResponse, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the StatusResponse, %+v the wide one and %#v the Go syntax.
+func (statusResponse *StatusResponse) Format(state fmt.State, verb rune) {
+	formatState(state, verb, statusResponse)
+}
+
+// String returns the compact rendering of the StorageCredResponse, see FormatModel.
+func (storageCredResponse *StorageCredResponse) String() string {
+	return FormatModel(storageCredResponse, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the StorageCredResponse, %+v the wide one and %#v the Go syntax.
+func (storageCredResponse *StorageCredResponse) Format(state fmt.State, verb rune) {
+	formatState(state, verb, storageCredResponse)
+}
+
+// String returns the compact rendering of the Volume, see FormatModel.
+func (volume *Volume) String() string {
+	return FormatModel(volume, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the Volume, %+v the wide one and %#v the Go syntax.
+func (volume *Volume) Format(state fmt.State, verb rune) {
+	formatState(state, verb, volume)
+}
+
+// String returns the compact rendering of the VolumeCollection, see FormatModel.
+func (volumeCollection *VolumeCollection) String() string {
+	return FormatModel(volumeCollection, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the VolumeCollection, %+v the wide one and %#v the Go syntax.
+func (volumeCollection *VolumeCollection) Format(state fmt.State, verb rune) {
+	formatState(state, verb, volumeCollection)
+}
+
+// String returns the compact rendering of the VolumeIdentity, see FormatModel.
+func (volumeIdentity *VolumeIdentity) String() string {
+	return FormatModel(volumeIdentity, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the VolumeIdentity, %+v the wide one and %#v the Go syntax.
+func (volumeIdentity *VolumeIdentity) Format(state fmt.State, verb rune) {
+	formatState(state, verb, volumeIdentity)
+}
+
+// String returns the compact rendering of the VolumeMapping, see FormatModel.
+func (volumeMapping *VolumeMapping) String() string {
+	return FormatModel(volumeMapping, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the VolumeMapping, %+v the wide one and %#v the Go syntax.
+func (volumeMapping *VolumeMapping) Format(state fmt.State, verb rune) {
+	formatState(state, verb, volumeMapping)
+}
+
+// String returns the compact rendering of the VolumeMappingCollection, see FormatModel.
+func (volumeMappingCollection *VolumeMappingCollection) String() string {
+	return FormatModel(volumeMappingCollection, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the VolumeMappingCollection, %+v the wide one and %#v the Go syntax.
+func (volumeMappingCollection *VolumeMappingCollection) Format(state fmt.State, verb rune) {
+	formatState(state, verb, volumeMappingCollection)
+}
+
+// String returns the compact rendering of the VolumeMappingPrototype, see FormatModel.
+func (volumeMappingPrototype *VolumeMappingPrototype) String() string {
+	return FormatModel(volumeMappingPrototype, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the VolumeMappingPrototype, %+v the wide one and %#v the Go syntax.
+func (volumeMappingPrototype *VolumeMappingPrototype) Format(state fmt.State, verb rune) {
+	formatState(state, verb, volumeMappingPrototype)
+}
+
+// String returns the compact rendering of the VolumeMappingReference, see FormatModel.
+func (volumeMappingReference *VolumeMappingReference) String() string {
+	return FormatModel(volumeMappingReference, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the VolumeMappingReference, %+v the wide one and %#v the Go syntax.
+func (volumeMappingReference *VolumeMappingReference) Format(state fmt.State, verb rune) {
+	formatState(state, verb, volumeMappingReference)
+}
+
+// String returns the compact rendering of the VolumePatch, see FormatModel.
+func (volumePatch *VolumePatch) String() string {
+	return FormatModel(volumePatch, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the VolumePatch, %+v the wide one and %#v the Go syntax.
+func (volumePatch *VolumePatch) Format(state fmt.State, verb rune) {
+	formatState(state, verb, volumePatch)
+}
+
+// String returns the compact rendering of the VolumeReference, see FormatModel.
+func (volumeReference *VolumeReference) String() string {
+	return FormatModel(volumeReference, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the VolumeReference, %+v the wide one and %#v the Go syntax.
+func (volumeReference *VolumeReference) Format(state fmt.State, verb rune) {
+	formatState(state, verb, volumeReference)
+}
+
+// String returns the compact rendering of the VolumeStatusReason, see FormatModel.
+func (volumeStatusReason *VolumeStatusReason) String() string {
+	return FormatModel(volumeStatusReason, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the VolumeStatusReason, %+v the wide one and %#v the Go syntax.
+func (volumeStatusReason *VolumeStatusReason) Format(state fmt.State, verb rune) {
+	formatState(state, verb, volumeStatusReason)
+}
+
+// String returns the compact rendering of the VolumeSummary, see FormatModel.
+func (volumeSummary *VolumeSummary) String() string {
+	return FormatModel(volumeSummary, nil)
+}
+
+// Format implements fmt.Formatter: %v and %s are the compact rendering of the VolumeSummary, %+v the wide one and %#v the Go syntax.
+func (volumeSummary *VolumeSummary) Format(state fmt.State, verb rune) {
+	formatState(state, verb, volumeSummary)
+}
@@ -0,0 +1,97 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2_test
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+	"github.com/go-openapi/strfmt"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Model formatting`, func() {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	createdAt := strfmt.DateTime(now.Add(-3 * time.Hour))
+	volume := func() *sdsaasv2.Volume {
+		status := sdsaasv2.VolumeStatusAvailableConst
+		return &sdsaasv2.Volume{
+			ID:           core.StringPtr("v1"),
+			Name:         core.StringPtr("data"),
+			Href:         core.StringPtr("https://sds.example/volumes/v1"),
+			ResourceType: core.StringPtr("volume"),
+			Status:       &status,
+			Capacity:     core.Int64Ptr(1536),
+			Bandwidth:    core.Int64Ptr(1000),
+			CreatedAt:    &createdAt,
+			VolumeMappings: []sdsaasv2.VolumeMapping{
+				{ID: core.StringPtr("m1"), Host: &sdsaasv2.HostReference{ID: core.StringPtr("h1"), Name: core.StringPtr("web 1")}},
+			},
+		}
+	}
+
+	It(`Renders compact and wide models`, func() {
+		Expect(volume().String()).To(Equal(`Volume{id=v1 name=data created_at=2026-10-19T09:00:00Z capacity=1.5TB ` +
+			`bandwidth=1000Mbps volume_mappings=[1] status=available}`))
+		Expect(sdsaasv2.FormatModel(volume(), &sdsaasv2.FormatOptions{RelativeTime: true, Now: now})).To(ContainSubstring(`created_at="3h ago"`))
+
+		wideVolume := volume()
+		wideVolume.SetProperty("encryption", "aes")
+		wide := fmt.Sprintf("%+v", wideVolume)
+		Expect(wide).To(ContainSubstring(` href=https://sds.example/volumes/v1 `))
+		Expect(wide).To(ContainSubstring(` resource_type=volume `))
+		Expect(wide).To(ContainSubstring(`volume_mappings=[{id=m1 host={id=h1 name="web 1"}}]`))
+		Expect(wide).To(HaveSuffix(` encryption=aes}`))
+
+		var missing *sdsaasv2.Volume
+		Expect(fmt.Sprintf("%v", missing)).To(Equal("<nil>"))
+		Expect(fmt.Sprintf("%#v", missing)).To(Equal("(*sdsaasv2.Volume)(nil)"))
+		Expect(fmt.Sprintf("%#v", volume())).To(HavePrefix(`&sdsaasv2.Volume{ID:(*string)(0x`))
+	})
+
+	It(`Redacts secrets`, func() {
+		accessKey := &sdsaasv2.AccessKeyResponse{AccessKey: core.StringPtr("key"), SecretKey: core.StringPtr("shh")}
+		accessKey.SetProperty("session_token", "abc")
+		Expect(fmt.Sprint(accessKey)).To(Equal(`AccessKeyResponse{access_key=key secret_key=[redacted]}`))
+		Expect(fmt.Sprintf("%+v", accessKey)).To(Equal(`AccessKeyResponse{access_key=key secret_key=[redacted] session_token=[redacted]}`))
+		goSyntax := fmt.Sprintf("%#v", accessKey)
+		Expect(goSyntax).To(HavePrefix(`&sdsaasv2.AccessKeyResponse{AccessKey:(*string)(0x`))
+		Expect(goSyntax).To(ContainSubstring(`"session_token":"[redacted]"`))
+		Expect(goSyntax).ToNot(ContainSubstring("shh"))
+		Expect(goSyntax).ToNot(ContainSubstring("abc"))
+		Expect(accessKey.GetProperty("session_token")).To(Equal("abc"))
+	})
+
+	It(`Renders tables of models`, func() {
+		var out bytes.Buffer
+		Expect(sdsaasv2.RenderTable(&out, []sdsaasv2.Volume{*volume()}, &sdsaasv2.FormatOptions{RelativeTime: true, Now: now})).To(Succeed())
+		Expect(out.String()).To(Equal("" +
+			"ID  NAME  CREATED AT  CAPACITY  SNAPSHOT COUNT  BANDWIDTH  IOPS  STATUS     SOURCE SNAPSHOT  VOLUME GROUP\n" +
+			"v1  data  3h ago      1.5TB     -               1000Mbps   -     available  -                -\n"))
+
+		out.Reset()
+		Expect(sdsaasv2.RenderTable(&out, []*sdsaasv2.Volume{volume()}, &sdsaasv2.FormatOptions{Wide: true})).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("HREF"))
+		Expect(out.String()).To(ContainSubstring("VOLUME MAPPINGS"))
+
+		Expect(sdsaasv2.RenderTable(&out, volume(), nil)).ToNot(Succeed())
+	})
+})
@@ -0,0 +1,504 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+	"github.com/go-openapi/strfmt"
+)
+
+// DefaultGarbageCollectorMaxDeletes is the number of delete calls a GarbageCollector will make in a single run when
+// GarbageCollectorOptions.MaxDeletes is not set.
+const DefaultGarbageCollectorMaxDeletes = 25
+
+// Constants associated with the GarbageCollectionEntry.Kind property.
+// The kind of resource (or group of resources) removed by a single delete call.
+const (
+	GarbageCollectionEntryKindHostConst           = "host"
+	GarbageCollectionEntryKindSnapshotConst       = "snapshot"
+	GarbageCollectionEntryKindSnapshotsConst      = "snapshots"
+	GarbageCollectionEntryKindVolumeConst         = "volume"
+	GarbageCollectionEntryKindVolumeMappingConst  = "volume_mapping"
+	GarbageCollectionEntryKindVolumeMappingsConst = "volume_mappings"
+)
+
+// Constants associated with the GarbageCollectionEntry.Action property.
+// The outcome of a single delete call.
+const (
+	GarbageCollectionEntryActionDeletedConst = "deleted"
+	GarbageCollectionEntryActionFailedConst  = "failed"
+	GarbageCollectionEntryActionPlannedConst = "planned"
+	GarbageCollectionEntryActionSkippedConst = "skipped"
+)
+
+// GarbageCollectorOptions : Options for a GarbageCollector.
+// At least one of NamePrefix or NameRegex must be set; a resource is selected when its name matches every selector
+// that is set and it is older than MinAge.
+type GarbageCollectorOptions struct {
+	// Select resources whose name starts with this prefix.
+	NamePrefix string
+
+	// Select resources whose name matches this expression.
+	NameRegex *regexp.Regexp
+
+	// Only select resources created at least this long ago.
+	MinAge time.Duration
+
+	// Skip collection of volumes (and the mappings and snapshots that belong to them).
+	SkipVolumes bool
+
+	// Skip collection of hosts (and the mappings that belong to them).
+	SkipHosts bool
+
+	// Skip collection of snapshots selected by name. Snapshots of collected volumes are always removed.
+	SkipSnapshots bool
+
+	// When set to true, the collector lists and selects resources but does not delete anything.
+	DryRun bool
+
+	// The maximum number of delete calls a single run may make. If the plan exceeds it, nothing is deleted.
+	// Defaults to DefaultGarbageCollectorMaxDeletes.
+	MaxDeletes int
+
+	// How long to wait between deletion phases, giving the service time to finish asynchronous unmapping and
+	// snapshot deletion before dependent resources are removed.
+	SettleTime time.Duration
+
+	// Returns the current time; defaults to time.Now.
+	Now func() time.Time
+}
+
+// GarbageCollector : Deletes stale volumes, hosts and snapshots selected by name and age.
+// Resources are removed in dependency order: volume mappings, then snapshots, then volumes, then hosts.
+type GarbageCollector struct {
+	client  *SdsaasV2
+	options GarbageCollectorOptions
+}
+
+// GarbageCollectionEntry : A single delete call made (or planned) by a GarbageCollector.
+type GarbageCollectionEntry struct {
+	// The kind of resource removed by this call.
+	Kind string
+
+	// The identifier of the resource. For "volume_mappings" and "snapshots" entries this is the host or source
+	// volume that owns the deleted collection.
+	ID string
+
+	// The name of the resource.
+	Name string
+
+	// The date and time when the resource was created.
+	CreatedAt time.Time
+
+	// The outcome of the call.
+	Action string
+
+	// The error returned by the call, or the reason the call was skipped.
+	Error error
+}
+
+// GarbageCollectionReport : The result of a GarbageCollector run.
+type GarbageCollectionReport struct {
+	// When set to true, nothing was deleted.
+	DryRun bool
+
+	// The time the run started.
+	StartedAt time.Time
+
+	// The time the run finished.
+	FinishedAt time.Time
+
+	// The delete calls in the order they were made.
+	Entries []GarbageCollectionEntry
+}
+
+// NewGarbageCollector : constructs a GarbageCollector that deletes resources through "sdsaas".
+func (sdsaas *SdsaasV2) NewGarbageCollector(options *GarbageCollectorOptions) (collector *GarbageCollector, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	if options.NamePrefix == "" && options.NameRegex == nil {
+		err = core.SDKErrorf(nil, "a name prefix or name expression is required to select resources", "gc-no-selector", common.GetComponentInfo())
+		return
+	}
+
+	collector = &GarbageCollector{
+		client:  sdsaas,
+		options: *options,
+	}
+	if collector.options.MaxDeletes <= 0 {
+		collector.options.MaxDeletes = DefaultGarbageCollectorMaxDeletes
+	}
+	if collector.options.Now == nil {
+		collector.options.Now = time.Now
+	}
+	return
+}
+
+// Run invokes RunWithContext() using context.Background() as the Context parameter.
+func (collector *GarbageCollector) Run() (report *GarbageCollectionReport, err error) {
+	report, err = collector.RunWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// RunWithContext lists the resources in the deployment, selects the stale ones and deletes them. The report is
+// returned even when an error occurs, and lists every delete call that was made or planned.
+func (collector *GarbageCollector) RunWithContext(ctx context.Context) (report *GarbageCollectionReport, err error) {
+	report = &GarbageCollectionReport{
+		DryRun:    collector.options.DryRun,
+		StartedAt: collector.options.Now(),
+	}
+	defer func() {
+		report.FinishedAt = collector.options.Now()
+	}()
+
+	plan, err := collector.plan(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "gc-plan-error")
+		return
+	}
+	report.Entries = plan.entries()
+
+	if len(report.Entries) > collector.options.MaxDeletes {
+		for i := range report.Entries {
+			report.Entries[i].Action = GarbageCollectionEntryActionSkippedConst
+		}
+		errMsg := fmt.Sprintf("the run would make %d delete calls, which exceeds the limit of %d", len(report.Entries), collector.options.MaxDeletes)
+		err = core.SDKErrorf(nil, errMsg, "gc-max-deletes-exceeded", common.GetComponentInfo())
+		return
+	}
+	if collector.options.DryRun {
+		return
+	}
+
+	// Each phase waits for the previous one, and a resource is only deleted if everything it depends on was removed.
+	failed := map[string]bool{}
+	phases := [][]string{
+		{GarbageCollectionEntryKindVolumeMappingsConst, GarbageCollectionEntryKindVolumeMappingConst},
+		{GarbageCollectionEntryKindSnapshotsConst, GarbageCollectionEntryKindSnapshotConst},
+		{GarbageCollectionEntryKindVolumeConst},
+		{GarbageCollectionEntryKindHostConst},
+	}
+	started := false
+	for _, kinds := range phases {
+		settled := false
+		for i := range report.Entries {
+			entry := &report.Entries[i]
+			if !containsString(kinds, entry.Kind) {
+				continue
+			}
+			if started && !settled && collector.options.SettleTime > 0 {
+				err = sleepWithContext(ctx, collector.options.SettleTime)
+				if err != nil {
+					err = core.SDKErrorf(err, "", "gc-context-error", common.GetComponentInfo())
+					return
+				}
+			}
+			started, settled = true, true
+
+			key := entry.key()
+			for _, dependency := range plan.dependencies[key] {
+				if failed[dependency] {
+					entry.Error = fmt.Errorf("a resource that %s '%s' depends on could not be deleted", entry.Kind, entry.ID)
+					break
+				}
+			}
+			if entry.Error != nil {
+				entry.Action = GarbageCollectionEntryActionSkippedConst
+				failed[key] = true
+				continue
+			}
+
+			entry.Error = collector.delete(ctx, entry)
+			if entry.Error != nil {
+				entry.Action = GarbageCollectionEntryActionFailedConst
+				failed[key] = true
+			} else {
+				entry.Action = GarbageCollectionEntryActionDeletedConst
+			}
+		}
+	}
+
+	if failures := report.Failed(); len(failures) > 0 {
+		errMsg := fmt.Sprintf("%d of %d delete calls did not succeed", len(failures), len(report.Entries))
+		err = core.SDKErrorf(nil, errMsg, "gc-delete-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// Deleted returns the entries that were deleted successfully.
+func (report *GarbageCollectionReport) Deleted() []GarbageCollectionEntry {
+	return report.withAction(GarbageCollectionEntryActionDeletedConst)
+}
+
+// Failed returns the entries that failed or were skipped because a dependency could not be deleted.
+func (report *GarbageCollectionReport) Failed() (entries []GarbageCollectionEntry) {
+	for _, entry := range report.Entries {
+		if entry.Action == GarbageCollectionEntryActionFailedConst ||
+			(entry.Action == GarbageCollectionEntryActionSkippedConst && entry.Error != nil) {
+			entries = append(entries, entry)
+		}
+	}
+	return
+}
+
+// String renders the report as one line per delete call.
+func (report *GarbageCollectionReport) String() string {
+	var sb strings.Builder
+	mode := ""
+	if report.DryRun {
+		mode = " (dry run)"
+	}
+	fmt.Fprintf(&sb, "garbage collection%s: %d delete calls, %d deleted, %d failed\n",
+		mode, len(report.Entries), len(report.Deleted()), len(report.Failed()))
+	for _, entry := range report.Entries {
+		fmt.Fprintf(&sb, "%-8s %-16s %s (%s)", entry.Action, entry.Kind, entry.ID, entry.Name)
+		if entry.Error != nil {
+			fmt.Fprintf(&sb, ": %s", entry.Error.Error())
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func (report *GarbageCollectionReport) withAction(action string) (entries []GarbageCollectionEntry) {
+	for _, entry := range report.Entries {
+		if entry.Action == action {
+			entries = append(entries, entry)
+		}
+	}
+	return
+}
+
+// gcPlan holds the delete calls of a run, grouped by phase, and the calls each one depends on.
+type gcPlan struct {
+	mappings     []GarbageCollectionEntry
+	snapshots    []GarbageCollectionEntry
+	volumes      []GarbageCollectionEntry
+	hosts        []GarbageCollectionEntry
+	dependencies map[string][]string
+}
+
+func (plan *gcPlan) entries() (entries []GarbageCollectionEntry) {
+	entries = append(entries, plan.mappings...)
+	entries = append(entries, plan.snapshots...)
+	entries = append(entries, plan.volumes...)
+	entries = append(entries, plan.hosts...)
+	return
+}
+
+func (plan *gcPlan) dependsOn(entry GarbageCollectionEntry, dependency GarbageCollectionEntry) {
+	plan.dependencies[entry.key()] = append(plan.dependencies[entry.key()], dependency.key())
+}
+
+func (collector *GarbageCollector) plan(ctx context.Context) (plan *gcPlan, err error) {
+	plan = &gcPlan{dependencies: map[string][]string{}}
+
+	var volumes []Volume
+	if !collector.options.SkipVolumes {
+		var pager *VolumesPager
+		pager, err = collector.client.NewVolumesPager(&ListVolumesOptions{})
+		if err == nil {
+			volumes, err = pager.GetAllWithContext(ctx)
+		}
+		if err != nil {
+			err = core.SDKErrorf(err, "", "gc-list-volumes-error", common.GetComponentInfo())
+			return
+		}
+	}
+
+	var hosts []Host
+	if !collector.options.SkipHosts {
+		var pager *HostsPager
+		pager, err = collector.client.NewHostsPager(&ListHostsOptions{})
+		if err == nil {
+			hosts, err = pager.GetAllWithContext(ctx)
+		}
+		if err != nil {
+			err = core.SDKErrorf(err, "", "gc-list-hosts-error", common.GetComponentInfo())
+			return
+		}
+	}
+
+	var snapshots []Snapshot
+	if !collector.options.SkipSnapshots {
+		var pager *SnapshotsPager
+		pager, err = collector.client.NewSnapshotsPager(&ListSnapshotsOptions{})
+		if err == nil {
+			snapshots, err = pager.GetAllWithContext(ctx)
+		}
+		if err != nil {
+			err = core.SDKErrorf(err, "", "gc-list-snapshots-error", common.GetComponentInfo())
+			return
+		}
+	}
+
+	// Mappings of a collected host are removed together with DeleteVolumeMappings.
+	hostMappings := map[string]GarbageCollectionEntry{}
+	for _, host := range hosts {
+		if !collector.selects(host.Name, host.CreatedAt) {
+			continue
+		}
+		entry := newGarbageCollectionEntry(GarbageCollectionEntryKindHostConst, host.ID, host.Name, host.CreatedAt)
+		mappings := newGarbageCollectionEntry(GarbageCollectionEntryKindVolumeMappingsConst, host.ID, host.Name, host.CreatedAt)
+		hostMappings[entry.ID] = mappings
+		if len(host.VolumeMappings) > 0 {
+			plan.mappings = append(plan.mappings, mappings)
+			plan.dependsOn(entry, mappings)
+		}
+		plan.hosts = append(plan.hosts, entry)
+	}
+
+	selectedVolumes := map[string]bool{}
+	for _, volume := range volumes {
+		if !collector.selects(volume.Name, volume.CreatedAt) {
+			continue
+		}
+		entry := newGarbageCollectionEntry(GarbageCollectionEntryKindVolumeConst, volume.ID, volume.Name, volume.CreatedAt)
+		selectedVolumes[entry.ID] = true
+
+		// Mappings to hosts that are not being collected are removed one at a time.
+		for _, mapping := range volume.VolumeMappings {
+			if mapping.Host == nil || mapping.Host.ID == nil || mapping.ID == nil {
+				continue
+			}
+			if mappings, ok := hostMappings[*mapping.Host.ID]; ok {
+				plan.dependsOn(entry, mappings)
+				continue
+			}
+			unmap := GarbageCollectionEntry{
+				Kind:   GarbageCollectionEntryKindVolumeMappingConst,
+				ID:     *mapping.Host.ID + "/" + *mapping.ID,
+				Name:   core.StringNilMapper(mapping.Host.Name),
+				Action: GarbageCollectionEntryActionPlannedConst,
+			}
+			plan.mappings = append(plan.mappings, unmap)
+			plan.dependsOn(entry, unmap)
+		}
+
+		if volume.SnapshotCount != nil && *volume.SnapshotCount > 0 {
+			snapshots := newGarbageCollectionEntry(GarbageCollectionEntryKindSnapshotsConst, volume.ID, volume.Name, volume.CreatedAt)
+			plan.snapshots = append(plan.snapshots, snapshots)
+			plan.dependsOn(entry, snapshots)
+		}
+		plan.volumes = append(plan.volumes, entry)
+	}
+
+	for _, snapshot := range snapshots {
+		if !collector.selects(snapshot.Name, snapshot.CreatedAt) {
+			continue
+		}
+		// Snapshots of collected volumes are already removed with DeleteSnapshots.
+		if snapshot.SourceVolume != nil && selectedVolumes[core.StringNilMapper(snapshot.SourceVolume.ID)] {
+			continue
+		}
+		if snapshot.Deletable != nil && !*snapshot.Deletable {
+			continue
+		}
+		entry := newGarbageCollectionEntry(GarbageCollectionEntryKindSnapshotConst, snapshot.ID, snapshot.Name, snapshot.CreatedAt)
+		plan.snapshots = append(plan.snapshots, entry)
+	}
+
+	return
+}
+
+// selects returns true if a resource with the specified name and creation time is stale.
+func (collector *GarbageCollector) selects(name *string, createdAt *strfmt.DateTime) bool {
+	if name == nil {
+		return false
+	}
+	if collector.options.NamePrefix != "" && !strings.HasPrefix(*name, collector.options.NamePrefix) {
+		return false
+	}
+	if collector.options.NameRegex != nil && !collector.options.NameRegex.MatchString(*name) {
+		return false
+	}
+	if collector.options.MinAge > 0 {
+		if createdAt == nil || collector.options.Now().Sub(time.Time(*createdAt)) < collector.options.MinAge {
+			return false
+		}
+	}
+	return true
+}
+
+func (collector *GarbageCollector) delete(ctx context.Context, entry *GarbageCollectionEntry) (err error) {
+	client := collector.client
+	switch entry.Kind {
+	case GarbageCollectionEntryKindVolumeMappingsConst:
+		_, err = client.DeleteVolumeMappingsWithContext(ctx, client.NewDeleteVolumeMappingsOptions(entry.ID))
+	case GarbageCollectionEntryKindVolumeMappingConst:
+		hostID, mappingID, _ := strings.Cut(entry.ID, "/")
+		_, err = client.DeleteVolumeMappingWithContext(ctx, client.NewDeleteVolumeMappingOptions(hostID, mappingID))
+	case GarbageCollectionEntryKindSnapshotsConst:
+		_, err = client.DeleteSnapshotsWithContext(ctx, client.NewDeleteSnapshotsOptions(entry.ID))
+	case GarbageCollectionEntryKindSnapshotConst:
+		_, err = client.DeleteSnapshotWithContext(ctx, client.NewDeleteSnapshotOptions(entry.ID))
+	case GarbageCollectionEntryKindVolumeConst:
+		_, err = client.DeleteVolumeWithContext(ctx, client.NewDeleteVolumeOptions(entry.ID))
+	case GarbageCollectionEntryKindHostConst:
+		_, err = client.DeleteHostWithContext(ctx, client.NewDeleteHostOptions(entry.ID))
+	}
+	return
+}
+
+func newGarbageCollectionEntry(kind string, id *string, name *string, createdAt *strfmt.DateTime) GarbageCollectionEntry {
+	entry := GarbageCollectionEntry{
+		Kind:   kind,
+		ID:     core.StringNilMapper(id),
+		Name:   core.StringNilMapper(name),
+		Action: GarbageCollectionEntryActionPlannedConst,
+	}
+	if createdAt != nil {
+		entry.CreatedAt = time.Time(*createdAt)
+	}
+	return entry
+}
+
+func (entry GarbageCollectionEntry) key() string {
+	return entry.Kind + ":" + entry.ID
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// sleepWithContext waits for the specified duration, returning early with the context's error if it is cancelled.
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,199 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`GarbageCollector`, func() {
+	var (
+		testServer    *httptest.Server
+		sdsaasService *sdsaasv2.SdsaasV2
+		mutex         sync.Mutex
+		deleted       []string
+		failPaths     map[string]bool
+	)
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		deleted = nil
+		failPaths = map[string]bool{}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			res.Header().Set("Content-type", "application/json")
+			if req.Method == http.MethodDelete {
+				mutex.Lock()
+				deleted = append(deleted, req.URL.RequestURI())
+				mutex.Unlock()
+				if failPaths[req.URL.RequestURI()] {
+					res.WriteHeader(409)
+					fmt.Fprint(res, `{"errors": [{"code": "conflict", "message": "conflict"}]}`)
+					return
+				}
+				res.WriteHeader(204)
+				return
+			}
+
+			Expect(req.Method).To(Equal("GET"))
+			res.WriteHeader(200)
+			switch req.URL.Path {
+			case "/volumes":
+				fmt.Fprint(res, `{"volumes": [
+					{"id": "vol-1", "name": "ci-vol-1", "created_at": "2026-01-01T00:00:00.000Z", "snapshot_count": 2,
+					 "volume_mappings": [{"id": "map-1", "host": {"id": "host-1", "name": "ci-host-1"}},
+					                     {"id": "map-2", "host": {"id": "host-keep", "name": "prod-host"}}]},
+					{"id": "vol-2", "name": "ci-vol-2", "created_at": "2026-01-10T11:00:00.000Z"},
+					{"id": "vol-3", "name": "prod-vol", "created_at": "2025-01-01T00:00:00.000Z"}
+				], "limit": 20, "total_count": 3}`)
+			case "/hosts":
+				fmt.Fprint(res, `{"hosts": [
+					{"id": "host-1", "name": "ci-host-1", "created_at": "2026-01-01T00:00:00.000Z",
+					 "volume_mappings": [{"id": "map-1"}]},
+					{"id": "host-2", "name": "ci-host-2", "created_at": "2026-01-01T00:00:00.000Z", "volume_mappings": []},
+					{"id": "host-keep", "name": "prod-host", "created_at": "2026-01-01T00:00:00.000Z"}
+				], "limit": 20, "total_count": 3}`)
+			case "/snapshots":
+				fmt.Fprint(res, `{"snapshots": [
+					{"id": "snap-1", "name": "ci-snap-1", "created_at": "2026-01-01T00:00:00.000Z", "deletable": true,
+					 "source_volume": {"id": "vol-1"}},
+					{"id": "snap-2", "name": "ci-snap-2", "created_at": "2026-01-01T00:00:00.000Z", "deletable": true,
+					 "source_volume": {"id": "vol-3"}},
+					{"id": "snap-3", "name": "ci-snap-3", "created_at": "2026-01-01T00:00:00.000Z", "deletable": false,
+					 "source_volume": {"id": "vol-3"}}
+				], "limit": 20, "total_count": 3}`)
+			default:
+				Fail("unexpected path " + req.URL.Path)
+			}
+		}))
+
+		var serviceErr error
+		sdsaasService, serviceErr = sdsaasv2.NewSdsaasV2(&sdsaasv2.SdsaasV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Requires a name selector`, func() {
+		collector, err := sdsaasService.NewGarbageCollector(&sdsaasv2.GarbageCollectorOptions{MinAge: time.Hour})
+		Expect(err).ToNot(BeNil())
+		Expect(collector).To(BeNil())
+
+		collector, err = sdsaasService.NewGarbageCollector(nil)
+		Expect(err).ToNot(BeNil())
+		Expect(collector).To(BeNil())
+	})
+	It(`Plans deletions without deleting in dry-run mode`, func() {
+		collector, err := sdsaasService.NewGarbageCollector(&sdsaasv2.GarbageCollectorOptions{
+			NamePrefix: "ci-",
+			MinAge:     24 * time.Hour,
+			DryRun:     true,
+			Now:        func() time.Time { return now },
+		})
+		Expect(err).To(BeNil())
+
+		report, err := collector.Run()
+		Expect(err).To(BeNil())
+		Expect(report.DryRun).To(BeTrue())
+		Expect(deleted).To(BeEmpty())
+
+		var planned []string
+		for _, entry := range report.Entries {
+			Expect(entry.Action).To(Equal(sdsaasv2.GarbageCollectionEntryActionPlannedConst))
+			planned = append(planned, entry.Kind+" "+entry.ID)
+		}
+		Expect(planned).To(Equal([]string{
+			"volume_mappings host-1",
+			"volume_mapping host-keep/map-2",
+			"snapshots vol-1",
+			"snapshot snap-2",
+			"volume vol-1",
+			"host host-1",
+			"host host-2",
+		}))
+		Expect(report.String()).To(ContainSubstring("(dry run)"))
+	})
+	It(`Deletes in dependency order`, func() {
+		collector, err := sdsaasService.NewGarbageCollector(&sdsaasv2.GarbageCollectorOptions{
+			NameRegex: regexp.MustCompile(`^ci-(vol|host)-1$`),
+			MinAge:    24 * time.Hour,
+			Now:       func() time.Time { return now },
+		})
+		Expect(err).To(BeNil())
+
+		report, err := collector.Run()
+		Expect(err).To(BeNil())
+		Expect(deleted).To(Equal([]string{
+			"/hosts/host-1/volume_mappings",
+			"/hosts/host-keep/volume_mappings/map-2",
+			"/snapshots?source_volume.id=vol-1",
+			"/volumes/vol-1",
+			"/hosts/host-1",
+		}))
+		Expect(report.Deleted()).To(HaveLen(5))
+		Expect(report.Failed()).To(BeEmpty())
+	})
+	It(`Skips resources whose dependencies could not be deleted`, func() {
+		failPaths["/hosts/host-1/volume_mappings"] = true
+		collector, err := sdsaasService.NewGarbageCollector(&sdsaasv2.GarbageCollectorOptions{
+			NamePrefix:    "ci-",
+			MinAge:        24 * time.Hour,
+			SkipSnapshots: true,
+			Now:           func() time.Time { return now },
+		})
+		Expect(err).To(BeNil())
+
+		report, err := collector.Run()
+		Expect(err).ToNot(BeNil())
+		Expect(deleted).ToNot(ContainElement("/volumes/vol-1"))
+		Expect(deleted).ToNot(ContainElement("/hosts/host-1"))
+		Expect(deleted).To(ContainElement("/hosts/host-2"))
+		Expect(report.Failed()).To(HaveLen(3))
+	})
+	It(`Refuses to run when the plan exceeds the delete limit`, func() {
+		collector, err := sdsaasService.NewGarbageCollector(&sdsaasv2.GarbageCollectorOptions{
+			NamePrefix: "ci-",
+			MaxDeletes: 2,
+			Now:        func() time.Time { return now },
+		})
+		Expect(err).To(BeNil())
+
+		report, err := collector.Run()
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("exceeds the limit of 2"))
+		Expect(deleted).To(BeEmpty())
+		Expect(report.Entries).ToNot(BeEmpty())
+		for _, entry := range report.Entries {
+			Expect(entry.Action).To(Equal(sdsaasv2.GarbageCollectionEntryActionSkippedConst))
+		}
+	})
+})
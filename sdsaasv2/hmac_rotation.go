@@ -0,0 +1,415 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+)
+
+// DefaultHmacVerifyTimeout is how long RotateHmacCredentials waits for a new access key to be listed when
+// RotateHmacCredentialsOptions.VerifyTimeout is not set.
+const DefaultHmacVerifyTimeout = time.Minute
+
+// DefaultHmacVerifyInterval is the time between list calls while RotateHmacCredentials waits for a new access key.
+const DefaultHmacVerifyInterval = 2 * time.Second
+
+// Constants associated with the HmacRotationState.Phase property.
+// The last step of a rotation that is known to have completed.
+const (
+	HmacRotationStatePhaseCompletedConst = "completed"
+	HmacRotationStatePhaseCreatingConst  = "creating"
+	HmacRotationStatePhaseGraceConst     = "grace"
+	HmacRotationStatePhaseStoredConst    = "stored"
+	HmacRotationStatePhaseVerifiedConst  = "verified"
+)
+
+// HmacCredentialSink : Receives a newly created HMAC credential, for example to write it to a secret store. The
+// secret key is only returned by the service once, so the rotation does not continue unless the sink succeeds.
+type HmacCredentialSink func(ctx context.Context, credentials *AccessKeyResponse) error
+
+// HmacRotationStateStore : Persists the progress of an HMAC credential rotation so that it can be resumed.
+type HmacRotationStateStore interface {
+	// Load returns the saved state, or nil if there is none.
+	Load(ctx context.Context) (*HmacRotationState, error)
+
+	// Save records the state.
+	Save(ctx context.Context, state *HmacRotationState) error
+}
+
+// HmacRotationState : The progress of an HMAC credential rotation.
+type HmacRotationState struct {
+	// The access key being replaced.
+	OldAccessKey string `json:"old_access_key"`
+
+	// The access key replacing it.
+	NewAccessKey string `json:"new_access_key"`
+
+	// The last step that is known to have completed.
+	Phase string `json:"phase"`
+
+	// The time the old access key may be deleted. Set once the new key has been verified.
+	GraceUntil *time.Time `json:"grace_until,omitempty"`
+
+	// The time the state was last updated.
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// RotateHmacCredentialsOptions : The RotateHmacCredentials options.
+type RotateHmacCredentialsOptions struct {
+	// The access key to replace.
+	OldAccessKey *string `validate:"required,ne="`
+
+	// The access key to create. Must differ from OldAccessKey.
+	NewAccessKey *string `validate:"required,ne="`
+
+	// Receives the new credential before the old one is removed.
+	Sink HmacCredentialSink `validate:"required"`
+
+	// How long both keys remain valid, giving clients time to pick up the new one.
+	GracePeriod time.Duration
+
+	// How long to wait for the new key to be listed. Defaults to DefaultHmacVerifyTimeout.
+	VerifyTimeout time.Duration
+
+	// The time between list calls while waiting for the new key. Defaults to DefaultHmacVerifyInterval.
+	VerifyInterval time.Duration
+
+	// Persists progress so that an interrupted rotation resumes where it stopped.
+	State HmacRotationStateStore
+
+	// Allows users to set headers on API requests.
+	Headers map[string]string
+}
+
+// NewRotateHmacCredentialsOptions : Instantiate RotateHmacCredentialsOptions
+func (*SdsaasV2) NewRotateHmacCredentialsOptions(oldAccessKey string, newAccessKey string, sink HmacCredentialSink) *RotateHmacCredentialsOptions {
+	return &RotateHmacCredentialsOptions{
+		OldAccessKey: core.StringPtr(oldAccessKey),
+		NewAccessKey: core.StringPtr(newAccessKey),
+		Sink:         sink,
+	}
+}
+
+// SetGracePeriod : Allow user to set GracePeriod
+func (_options *RotateHmacCredentialsOptions) SetGracePeriod(gracePeriod time.Duration) *RotateHmacCredentialsOptions {
+	_options.GracePeriod = gracePeriod
+	return _options
+}
+
+// SetState : Allow user to set State
+func (_options *RotateHmacCredentialsOptions) SetState(state HmacRotationStateStore) *RotateHmacCredentialsOptions {
+	_options.State = state
+	return _options
+}
+
+// SetHeaders : Allow user to set Headers
+func (options *RotateHmacCredentialsOptions) SetHeaders(param map[string]string) *RotateHmacCredentialsOptions {
+	options.Headers = param
+	return options
+}
+
+// RotateHmacCredentials : Rotate HMAC credentials
+// Creates a new HMAC credential, hands it to the sink, verifies that it is listed, waits through the grace period and
+// deletes the old credential.
+func (sdsaas *SdsaasV2) RotateHmacCredentials(rotateHmacCredentialsOptions *RotateHmacCredentialsOptions) (result *HmacRotationState, err error) {
+	result, err = sdsaas.RotateHmacCredentialsWithContext(context.Background(), rotateHmacCredentialsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// RotateHmacCredentialsWithContext is an alternate form of the RotateHmacCredentials method which supports a Context
+// parameter. If a saved state for the same pair of access keys exists, the rotation resumes from it. A rotation that
+// stopped after creating the new key but before the sink stored it cannot recover the secret key, so the new key is
+// deleted and created again.
+func (sdsaas *SdsaasV2) RotateHmacCredentialsWithContext(ctx context.Context, rotateHmacCredentialsOptions *RotateHmacCredentialsOptions) (result *HmacRotationState, err error) {
+	err = core.ValidateNotNil(rotateHmacCredentialsOptions, "rotateHmacCredentialsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateStruct(rotateHmacCredentialsOptions, "rotateHmacCredentialsOptions")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+		return
+	}
+	options := rotateHmacCredentialsOptions
+	if *options.OldAccessKey == *options.NewAccessKey {
+		err = core.SDKErrorf(nil, "the new access key must differ from the old access key", "same-access-key", common.GetComponentInfo())
+		return
+	}
+
+	rotation := &hmacRotation{client: sdsaas, options: options}
+	result, err = rotation.load(ctx)
+	if err != nil {
+		return
+	}
+
+	for result.Phase != HmacRotationStatePhaseCompletedConst {
+		err = rotation.step(ctx, result)
+		if err != nil {
+			return
+		}
+		result.UpdatedAt = time.Now()
+		err = rotation.save(ctx, result)
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+type hmacRotation struct {
+	client  *SdsaasV2
+	options *RotateHmacCredentialsOptions
+
+	// Set to true when the rotation continues from a saved state.
+	resumed bool
+}
+
+// load returns the saved state for this rotation, or a new state if there is none.
+func (rotation *hmacRotation) load(ctx context.Context) (state *HmacRotationState, err error) {
+	if rotation.options.State != nil {
+		state, err = rotation.options.State.Load(ctx)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "load-rotation-state-error", common.GetComponentInfo())
+			return
+		}
+	}
+	if state == nil || state.OldAccessKey != *rotation.options.OldAccessKey || state.NewAccessKey != *rotation.options.NewAccessKey ||
+		state.Phase == HmacRotationStatePhaseCompletedConst {
+		state = &HmacRotationState{
+			OldAccessKey: *rotation.options.OldAccessKey,
+			NewAccessKey: *rotation.options.NewAccessKey,
+		}
+		return
+	}
+	rotation.resumed = true
+	return
+}
+
+func (rotation *hmacRotation) save(ctx context.Context, state *HmacRotationState) (err error) {
+	if rotation.options.State == nil {
+		return
+	}
+	err = rotation.options.State.Save(ctx, state)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "save-rotation-state-error", common.GetComponentInfo())
+	}
+	return
+}
+
+// step performs the next step of the rotation and advances the state's phase.
+func (rotation *hmacRotation) step(ctx context.Context, state *HmacRotationState) (err error) {
+	client := rotation.client
+	options := rotation.options
+
+	switch state.Phase {
+	case "":
+		// Record the intent first so that a crash during creation leaves a trace of the new key.
+		state.Phase = HmacRotationStatePhaseCreatingConst
+
+	case HmacRotationStatePhaseCreatingConst:
+		var listed bool
+		listed, err = rotation.listed(ctx, state.NewAccessKey)
+		if err != nil {
+			return
+		}
+		if listed && !rotation.resumed {
+			errMsg := fmt.Sprintf("the access key '%s' already exists", state.NewAccessKey)
+			err = core.SDKErrorf(nil, errMsg, "new-key-exists", common.GetComponentInfo())
+			return
+		}
+		if listed {
+			// The key was created by an interrupted attempt, and its secret key is lost.
+			deleteOptions := client.NewDeleteHmacCredentialsOptions(state.NewAccessKey).SetHeaders(options.Headers)
+			_, err = client.DeleteHmacCredentialsWithContext(ctx, deleteOptions)
+			if err != nil {
+				err = core.RepurposeSDKProblem(err, "delete-orphaned-key-error")
+				return
+			}
+		}
+
+		var credentials *AccessKeyResponse
+		createOptions := client.NewCreateHmacCredentialsOptions(state.NewAccessKey).SetHeaders(options.Headers)
+		credentials, _, err = client.CreateHmacCredentialsWithContext(ctx, createOptions)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "create-key-error")
+			return
+		}
+		if credentials == nil || credentials.SecretKey == nil {
+			err = core.SDKErrorf(nil, "the service did not return a secret key for the new credential", "missing-secret-key", common.GetComponentInfo())
+			return
+		}
+		err = options.Sink(ctx, credentials)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "credential-sink-error", common.GetComponentInfo())
+			return
+		}
+		state.Phase = HmacRotationStatePhaseStoredConst
+
+	case HmacRotationStatePhaseStoredConst:
+		err = rotation.verify(ctx, state.NewAccessKey)
+		if err != nil {
+			return
+		}
+		graceUntil := time.Now().Add(options.GracePeriod)
+		state.GraceUntil = &graceUntil
+		state.Phase = HmacRotationStatePhaseVerifiedConst
+
+	case HmacRotationStatePhaseVerifiedConst:
+		if state.GraceUntil != nil {
+			err = sleepWithContext(ctx, time.Until(*state.GraceUntil))
+			if err != nil {
+				err = core.SDKErrorf(err, "", "grace-period-interrupted", common.GetComponentInfo())
+				return
+			}
+		}
+		state.Phase = HmacRotationStatePhaseGraceConst
+
+	case HmacRotationStatePhaseGraceConst:
+		deleteOptions := client.NewDeleteHmacCredentialsOptions(state.OldAccessKey).SetHeaders(options.Headers)
+		var response *core.DetailedResponse
+		response, err = client.DeleteHmacCredentialsWithContext(ctx, deleteOptions)
+		// The old key may already be gone if a previous attempt stopped after deleting it.
+		if err != nil && (response == nil || response.StatusCode != http.StatusNotFound) {
+			err = core.RepurposeSDKProblem(err, "delete-old-key-error")
+			return
+		}
+		err = nil
+		state.Phase = HmacRotationStatePhaseCompletedConst
+
+	default:
+		errMsg := fmt.Sprintf("the saved rotation state has an unknown phase '%s'", state.Phase)
+		err = core.SDKErrorf(nil, errMsg, "unknown-rotation-phase", common.GetComponentInfo())
+	}
+	return
+}
+
+// verify waits until the access key is listed.
+func (rotation *hmacRotation) verify(ctx context.Context, accessKey string) (err error) {
+	timeout := rotation.options.VerifyTimeout
+	if timeout <= 0 {
+		timeout = DefaultHmacVerifyTimeout
+	}
+	interval := rotation.options.VerifyInterval
+	if interval <= 0 {
+		interval = DefaultHmacVerifyInterval
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		var listed bool
+		listed, err = rotation.listed(ctx, accessKey)
+		if err != nil || listed {
+			return
+		}
+		if time.Now().Add(interval).After(deadline) {
+			errMsg := fmt.Sprintf("the access key '%s' was not listed within %s", accessKey, timeout)
+			err = core.SDKErrorf(nil, errMsg, "verify-key-timeout", common.GetComponentInfo())
+			return
+		}
+		err = sleepWithContext(ctx, interval)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "verify-key-interrupted", common.GetComponentInfo())
+			return
+		}
+	}
+}
+
+func (rotation *hmacRotation) listed(ctx context.Context, accessKey string) (listed bool, err error) {
+	listOptions := rotation.client.NewListHmacCredentialsOptions().SetHeaders(rotation.options.Headers)
+	result, _, err := rotation.client.ListHmacCredentialsWithContext(ctx, listOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "list-keys-error")
+		return
+	}
+	if result != nil {
+		listed = containsString(result.S3Credentials, accessKey)
+	}
+	return
+}
+
+// HmacRotationFileState : An HmacRotationStateStore that keeps the state in a JSON file.
+type HmacRotationFileState struct {
+	// The path of the state file.
+	Path string
+}
+
+// NewHmacRotationFileState : Instantiate HmacRotationFileState
+func NewHmacRotationFileState(path string) *HmacRotationFileState {
+	return &HmacRotationFileState{Path: path}
+}
+
+// Load returns the state saved in the file, or nil if the file does not exist.
+func (store *HmacRotationFileState) Load(ctx context.Context) (state *HmacRotationState, err error) {
+	data, err := os.ReadFile(store.Path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return
+	}
+	state = new(HmacRotationState)
+	err = json.Unmarshal(data, state)
+	return
+}
+
+// Save replaces the file atomically with the state, readable only by the owner.
+func (store *HmacRotationFileState) Save(ctx context.Context, state *HmacRotationState) (err error) {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return
+	}
+	err = writeFileAtomic(store.Path, data, 0600)
+	return
+}
+
+// writeFileAtomic writes data to a temporary file in the same directory and renames it over "path", so readers never
+// see a partially written file.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) (err error) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return
+	}
+	defer os.Remove(tmp.Name())
+
+	err = tmp.Chmod(perm)
+	if err == nil {
+		_, err = tmp.Write(data)
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return
+	}
+	err = os.Rename(tmp.Name(), path)
+	return
+}
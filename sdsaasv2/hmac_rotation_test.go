@@ -0,0 +1,195 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`RotateHmacCredentials`, func() {
+	var (
+		testServer    *httptest.Server
+		sdsaasService *sdsaasv2.SdsaasV2
+		mutex         sync.Mutex
+		keys          map[string]bool
+		calls         []string
+		stored        []*sdsaasv2.AccessKeyResponse
+		statePath     string
+	)
+	sink := func(ctx context.Context, credentials *sdsaasv2.AccessKeyResponse) error {
+		stored = append(stored, credentials)
+		return nil
+	}
+	listKeys := func() (list []string) {
+		for key := range keys {
+			list = append(list, key)
+		}
+		sort.Strings(list)
+		return
+	}
+
+	BeforeEach(func() {
+		keys = map[string]bool{"app-key-1": true, "other-key": true}
+		calls = nil
+		stored = nil
+		statePath = filepath.Join(GinkgoT().TempDir(), "rotation.json")
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			res.Header().Set("Content-type", "application/json")
+			key := strings.TrimPrefix(req.URL.Path, "/s3_credentials/")
+			calls = append(calls, req.Method+" "+req.URL.Path)
+			switch {
+			case req.Method == "GET" && req.URL.Path == "/s3_credentials":
+				res.WriteHeader(200)
+				list, _ := json.Marshal(listKeys())
+				fmt.Fprintf(res, `{"s3_credentials": %s}`, list)
+			case req.Method == "POST":
+				keys[key] = true
+				res.WriteHeader(201)
+				fmt.Fprintf(res, `{"access_key": "%s", "secret_key": "secret-for-%s"}`, key, key)
+			case req.Method == "DELETE" && keys[key]:
+				delete(keys, key)
+				res.WriteHeader(204)
+			case req.Method == "DELETE":
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "not found"}]}`)
+			default:
+				Fail("unexpected request " + req.Method + " " + req.URL.Path)
+			}
+		}))
+
+		var serviceErr error
+		sdsaasService, serviceErr = sdsaasv2.NewSdsaasV2(&sdsaasv2.SdsaasV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(serviceErr).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	saveState := func(state *sdsaasv2.HmacRotationState) {
+		Expect(sdsaasv2.NewHmacRotationFileState(statePath).Save(context.Background(), state)).To(Succeed())
+	}
+
+	It(`Validates its options`, func() {
+		_, err := sdsaasService.RotateHmacCredentials(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = sdsaasService.RotateHmacCredentials(sdsaasService.NewRotateHmacCredentialsOptions("app-key-1", "app-key-2", nil))
+		Expect(err).ToNot(BeNil())
+		_, err = sdsaasService.RotateHmacCredentials(sdsaasService.NewRotateHmacCredentialsOptions("app-key-1", "app-key-1", sink))
+		Expect(err).ToNot(BeNil())
+		Expect(calls).To(BeEmpty())
+	})
+	It(`Rotates a credential and records its progress`, func() {
+		rotateOptions := sdsaasService.NewRotateHmacCredentialsOptions("app-key-1", "app-key-2", sink).
+			SetGracePeriod(10 * time.Millisecond).
+			SetState(sdsaasv2.NewHmacRotationFileState(statePath))
+
+		state, err := sdsaasService.RotateHmacCredentials(rotateOptions)
+		Expect(err).To(BeNil())
+		Expect(state.Phase).To(Equal(sdsaasv2.HmacRotationStatePhaseCompletedConst))
+		Expect(listKeys()).To(Equal([]string{"app-key-2", "other-key"}))
+		Expect(stored).To(HaveLen(1))
+		Expect(*stored[0].SecretKey).To(Equal("secret-for-app-key-2"))
+
+		saved, err := sdsaasv2.NewHmacRotationFileState(statePath).Load(context.Background())
+		Expect(err).To(BeNil())
+		Expect(saved.Phase).To(Equal(sdsaasv2.HmacRotationStatePhaseCompletedConst))
+		info, err := os.Stat(statePath)
+		Expect(err).To(BeNil())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+	})
+	It(`Refuses to replace an access key that already exists`, func() {
+		_, err := sdsaasService.RotateHmacCredentials(sdsaasService.NewRotateHmacCredentialsOptions("app-key-1", "other-key", sink))
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("already exists"))
+		Expect(listKeys()).To(Equal([]string{"app-key-1", "other-key"}))
+	})
+	It(`Keeps the old credential when the sink fails`, func() {
+		failingSink := func(ctx context.Context, credentials *sdsaasv2.AccessKeyResponse) error {
+			return errors.New("secret store unavailable")
+		}
+		rotateOptions := sdsaasService.NewRotateHmacCredentialsOptions("app-key-1", "app-key-2", failingSink).
+			SetState(sdsaasv2.NewHmacRotationFileState(statePath))
+		_, err := sdsaasService.RotateHmacCredentials(rotateOptions)
+		Expect(err).ToNot(BeNil())
+		Expect(keys["app-key-1"]).To(BeTrue())
+
+		// Resuming recreates the new key, since its secret key was never stored.
+		rotateOptions.Sink = sink
+		state, err := sdsaasService.RotateHmacCredentials(rotateOptions)
+		Expect(err).To(BeNil())
+		Expect(state.Phase).To(Equal(sdsaasv2.HmacRotationStatePhaseCompletedConst))
+		Expect(calls).To(ContainElement("DELETE /s3_credentials/app-key-2"))
+		Expect(listKeys()).To(Equal([]string{"app-key-2", "other-key"}))
+		Expect(stored).To(HaveLen(1))
+	})
+	It(`Resumes an interrupted grace period`, func() {
+		keys["app-key-2"] = true
+		graceUntil := time.Now().Add(-time.Minute)
+		saveState(&sdsaasv2.HmacRotationState{
+			OldAccessKey: "app-key-1",
+			NewAccessKey: "app-key-2",
+			Phase:        sdsaasv2.HmacRotationStatePhaseVerifiedConst,
+			GraceUntil:   &graceUntil,
+		})
+
+		rotateOptions := sdsaasService.NewRotateHmacCredentialsOptions("app-key-1", "app-key-2", sink).
+			SetState(sdsaasv2.NewHmacRotationFileState(statePath))
+		state, err := sdsaasService.RotateHmacCredentials(rotateOptions)
+		Expect(err).To(BeNil())
+		Expect(state.Phase).To(Equal(sdsaasv2.HmacRotationStatePhaseCompletedConst))
+		Expect(calls).To(Equal([]string{"DELETE /s3_credentials/app-key-1"}))
+		Expect(stored).To(BeEmpty())
+	})
+	It(`Treats an already deleted old credential as done`, func() {
+		keys["app-key-2"] = true
+		delete(keys, "app-key-1")
+		saveState(&sdsaasv2.HmacRotationState{
+			OldAccessKey: "app-key-1",
+			NewAccessKey: "app-key-2",
+			Phase:        sdsaasv2.HmacRotationStatePhaseGraceConst,
+		})
+
+		rotateOptions := sdsaasService.NewRotateHmacCredentialsOptions("app-key-1", "app-key-2", sink).
+			SetState(sdsaasv2.NewHmacRotationFileState(statePath))
+		state, err := sdsaasService.RotateHmacCredentials(rotateOptions)
+		Expect(err).To(BeNil())
+		Expect(state.Phase).To(Equal(sdsaasv2.HmacRotationStatePhaseCompletedConst))
+	})
+})
@@ -0,0 +1,303 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+)
+
+// The indexes of the resources cached by an Informer.
+const (
+	// IndexName indexes the volumes, hosts and snapshots by name.
+	IndexName = "name"
+
+	// IndexNQN indexes the hosts by NQN.
+	IndexNQN = "nqn"
+
+	// IndexSourceVolumeID indexes the snapshots by the ID of their source volume.
+	IndexSourceVolumeID = "source_volume_id"
+
+	// IndexHostID indexes the volume mappings by the ID of their host.
+	IndexHostID = "host_id"
+)
+
+// informerIndexers returns the values of each index of a kind of resource for a resource.
+var informerIndexers = map[string]map[string]func(object interface{}) *string{
+	WatchResourceVolumeConst: {
+		IndexName: func(object interface{}) *string { return object.(*Volume).Name },
+	},
+	WatchResourceHostConst: {
+		IndexName: func(object interface{}) *string { return object.(*Host).Name },
+		IndexNQN:  func(object interface{}) *string { return object.(*Host).Nqn },
+	},
+	WatchResourceVolumeMappingConst: {
+		IndexHostID: func(object interface{}) *string {
+			if host := object.(*VolumeMapping).Host; host != nil {
+				return host.ID
+			}
+			return nil
+		},
+	},
+	WatchResourceSnapshotConst: {
+		IndexName: func(object interface{}) *string { return object.(*Snapshot).Name },
+		IndexSourceVolumeID: func(object interface{}) *string {
+			if sourceVolume := object.(*Snapshot).SourceVolume; sourceVolume != nil {
+				return sourceVolume.ID
+			}
+			return nil
+		},
+	},
+}
+
+// InformerStatus : The state of the cache of an Informer.
+type InformerStatus struct {
+	// When set to true, every kind of resource has been listed at least once.
+	Synced bool
+
+	// When each kind of resource was last listed successfully.
+	LastRefresh map[string]time.Time
+
+	// The error of the last list of each kind of resource, if it failed.
+	LastError map[string]error
+}
+
+// Informer : A local cache of the resources of the service, kept up to date by listing them periodically (see
+// Watch). It answers the reads of controllers from memory, through its listers, and calls its event handlers for
+// every change. It is safe for concurrent use.
+type Informer struct {
+	watcher *watcher
+
+	mutex       sync.RWMutex
+	objects     map[string]map[string]interface{}
+	indexes     map[string]map[string]map[string][]string
+	lastRefresh map[string]time.Time
+	lastError   map[string]error
+	handlers    []func(event WatchEvent)
+	synced      chan struct{}
+}
+
+// NewInformer : constructs an Informer for the resources of the service. The options are those of Watch; the
+// BufferSize is not used. The cache is empty until Run is called.
+func (sdsaas *SdsaasV2) NewInformer(options *WatchOptions) (informer *Informer, err error) {
+	watcher, err := sdsaas.newWatcher(options)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "new-informer-error")
+		return
+	}
+	informer = &Informer{
+		watcher:     watcher,
+		objects:     map[string]map[string]interface{}{},
+		indexes:     map[string]map[string]map[string][]string{},
+		lastRefresh: map[string]time.Time{},
+		lastError:   map[string]error{},
+		synced:      make(chan struct{}),
+	}
+	watcher.emit = informer.apply
+	watcher.polled = informer.polled
+	return
+}
+
+// AddEventHandler registers a function called for every added, modified, deleted and error event, after the cache
+// has been updated. Handlers are called one at a time, in the order of the events, and must not block.
+func (informer *Informer) AddEventHandler(handler func(event WatchEvent)) {
+	informer.mutex.Lock()
+	defer informer.mutex.Unlock()
+	informer.handlers = append(informer.handlers, handler)
+}
+
+// Run lists the resources every interval and updates the cache until the context is cancelled. An Informer must
+// only be run once.
+func (informer *Informer) Run(ctx context.Context) error {
+	informer.watcher.run(ctx)
+	return ctx.Err()
+}
+
+// HasSynced reports whether every kind of resource has been listed at least once.
+func (informer *Informer) HasSynced() bool {
+	select {
+	case <-informer.synced:
+		return true
+	default:
+		return false
+	}
+}
+
+// WaitForSync waits until every kind of resource has been listed at least once, or the context is cancelled.
+func (informer *Informer) WaitForSync(ctx context.Context) error {
+	select {
+	case <-informer.synced:
+		return nil
+	case <-ctx.Done():
+		return core.SDKErrorf(ctx.Err(), "the informer cache did not sync", "informer-sync-error", common.GetComponentInfo())
+	}
+}
+
+// Status returns the state of the cache.
+func (informer *Informer) Status() *InformerStatus {
+	informer.mutex.RLock()
+	defer informer.mutex.RUnlock()
+	return &InformerStatus{
+		Synced:      informer.HasSynced(),
+		LastRefresh: maps.Clone(informer.lastRefresh),
+		LastError:   maps.Clone(informer.lastError),
+	}
+}
+
+// Volumes returns the lister of the cached volumes.
+func (informer *Informer) Volumes() *Lister[Volume] {
+	return &Lister[Volume]{informer: informer, resource: WatchResourceVolumeConst}
+}
+
+// Hosts returns the lister of the cached hosts.
+func (informer *Informer) Hosts() *Lister[Host] {
+	return &Lister[Host]{informer: informer, resource: WatchResourceHostConst}
+}
+
+// VolumeMappings returns the lister of the cached volume mappings.
+func (informer *Informer) VolumeMappings() *Lister[VolumeMapping] {
+	return &Lister[VolumeMapping]{informer: informer, resource: WatchResourceVolumeMappingConst}
+}
+
+// Snapshots returns the lister of the cached snapshots.
+func (informer *Informer) Snapshots() *Lister[Snapshot] {
+	return &Lister[Snapshot]{informer: informer, resource: WatchResourceSnapshotConst}
+}
+
+// apply updates the cache with an event of the watcher and calls the handlers.
+func (informer *Informer) apply(ctx context.Context, event WatchEvent) bool {
+	informer.mutex.Lock()
+	objects := informer.objects[event.Resource]
+	if objects == nil {
+		objects = map[string]interface{}{}
+		informer.objects[event.Resource] = objects
+	}
+	switch event.Type {
+	case WatchEventTypeAddedConst, WatchEventTypeModifiedConst:
+		if old, exists := objects[event.ID]; exists {
+			informer.unindex(event.Resource, event.ID, old)
+		}
+		objects[event.ID] = event.Object
+		informer.index(event.Resource, event.ID, event.Object)
+	case WatchEventTypeDeletedConst:
+		informer.unindex(event.Resource, event.ID, objects[event.ID])
+		delete(objects, event.ID)
+	}
+	handlers := slices.Clone(informer.handlers)
+	informer.mutex.Unlock()
+
+	for _, handler := range handlers {
+		handler(event)
+	}
+	return ctx.Err() == nil
+}
+
+// polled records the outcome of a list of the watcher.
+func (informer *Informer) polled(resource string, err error) {
+	informer.mutex.Lock()
+	defer informer.mutex.Unlock()
+	if err != nil {
+		informer.lastError[resource] = err
+		return
+	}
+	delete(informer.lastError, resource)
+	informer.lastRefresh[resource] = time.Now()
+	if !informer.HasSynced() && len(informer.lastRefresh) == len(informer.watcher.options.Resources) {
+		close(informer.synced)
+	}
+}
+
+func (informer *Informer) index(resource string, id string, object interface{}) {
+	for name, indexer := range informerIndexers[resource] {
+		value := indexer(object)
+		if value == nil {
+			continue
+		}
+		if informer.indexes[resource] == nil {
+			informer.indexes[resource] = map[string]map[string][]string{}
+		}
+		if informer.indexes[resource][name] == nil {
+			informer.indexes[resource][name] = map[string][]string{}
+		}
+		informer.indexes[resource][name][*value] = append(informer.indexes[resource][name][*value], id)
+	}
+}
+
+func (informer *Informer) unindex(resource string, id string, object interface{}) {
+	for name, indexer := range informerIndexers[resource] {
+		value := indexer(object)
+		if value == nil {
+			continue
+		}
+		index := informer.indexes[resource][name]
+		index[*value] = slices.DeleteFunc(index[*value], func(indexed string) bool { return indexed == id })
+		if len(index[*value]) == 0 {
+			delete(index, *value)
+		}
+	}
+}
+
+// Lister : Reads the resources of a kind cached by an Informer. The returned resources are shared by every reader
+// of the cache and must not be modified.
+type Lister[T any] struct {
+	informer *Informer
+	resource string
+}
+
+// List returns every cached resource, sorted by ID.
+func (lister *Lister[T]) List() []*T {
+	informer := lister.informer
+	informer.mutex.RLock()
+	defer informer.mutex.RUnlock()
+	objects := informer.objects[lister.resource]
+	return lister.collect(slices.Sorted(maps.Keys(objects)))
+}
+
+// Get returns the cached resource with the ID, or nil.
+func (lister *Lister[T]) Get(id string) *T {
+	informer := lister.informer
+	informer.mutex.RLock()
+	defer informer.mutex.RUnlock()
+	object, _ := informer.objects[lister.resource][id].(*T)
+	return object
+}
+
+// ByIndex returns the cached resources with a value of an index, e.g. the volumes with IndexName "data", sorted by
+// ID.
+func (lister *Lister[T]) ByIndex(index string, value string) []*T {
+	informer := lister.informer
+	informer.mutex.RLock()
+	defer informer.mutex.RUnlock()
+	ids := slices.Clone(informer.indexes[lister.resource][index][value])
+	slices.Sort(ids)
+	return lister.collect(ids)
+}
+
+// collect returns the resources with the IDs; the caller holds the lock.
+func (lister *Lister[T]) collect(ids []string) []*T {
+	results := make([]*T, 0, len(ids))
+	for _, id := range ids {
+		results = append(results, lister.informer.objects[lister.resource][id].(*T))
+	}
+	return results
+}
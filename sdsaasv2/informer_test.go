@@ -0,0 +1,161 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Informer`, func() {
+	var (
+		testServer    *httptest.Server
+		sdsaasService *sdsaasv2.SdsaasV2
+		mutex         sync.Mutex
+		volumeName    string
+		snapshotsDown bool
+		ctx           context.Context
+		cancel        context.CancelFunc
+	)
+	BeforeEach(func() {
+		volumeName = "data"
+		snapshotsDown = false
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.Path {
+			case "/volumes":
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"volumes": [{"id": "v1", "name": "%s"}, {"id": "v2", "name": "logs"}, {"id": "v3", "name": "logs"}]}`, volumeName)
+			case "/hosts":
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"hosts": [{"id": "h1", "name": "node-1", "nqn": "nqn.1"}, {"id": "h2", "name": "node-2", "nqn": "nqn.2"}]}`)
+			case "/hosts/h1/volume_mappings":
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"volume_mappings": [{"id": "m1", "status": "mapped"}, {"id": "m2", "status": "mapped"}]}`)
+			case "/hosts/h2/volume_mappings":
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"volume_mappings": [{"id": "m3", "status": "mapped", "host": {"id": "h2"}}]}`)
+			case "/snapshots":
+				if snapshotsDown {
+					res.WriteHeader(500)
+					fmt.Fprint(res, `{"errors": [{"code": "internal_error", "message": "down"}]}`)
+					return
+				}
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"snapshots": [{"id": "s1", "name": "nightly", "source_volume": {"id": "v1"}}, {"id": "s2", "name": "manual"}]}`)
+			}
+		}))
+		var err error
+		sdsaasService, err = sdsaasv2.NewSdsaasV2(&sdsaasv2.SdsaasV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+	AfterEach(func() {
+		cancel()
+		testServer.Close()
+	})
+
+	startInformer := func() *sdsaasv2.Informer {
+		informer, err := sdsaasService.NewInformer(&sdsaasv2.WatchOptions{Interval: 10 * time.Millisecond})
+		Expect(err).To(BeNil())
+		go informer.Run(ctx)
+		return informer
+	}
+
+	It(`Caches and indexes every kind of resource`, func() {
+		informer := startInformer()
+		var (
+			eventsMutex sync.Mutex
+			events      []string
+		)
+		informer.AddEventHandler(func(event sdsaasv2.WatchEvent) {
+			eventsMutex.Lock()
+			defer eventsMutex.Unlock()
+			events = append(events, event.Type+" "+event.ID)
+		})
+		Expect(informer.WaitForSync(ctx)).To(Succeed())
+		Expect(informer.HasSynced()).To(BeTrue())
+
+		Expect(informer.Volumes().List()).To(HaveLen(3))
+		Expect(*informer.Volumes().Get("v1").Name).To(Equal("data"))
+		Expect(informer.Volumes().Get("v9")).To(BeNil())
+		logs := informer.Volumes().ByIndex(sdsaasv2.IndexName, "logs")
+		Expect(logs).To(HaveLen(2))
+		Expect(*logs[0].ID).To(Equal("v2"))
+
+		hosts := informer.Hosts().ByIndex(sdsaasv2.IndexNQN, "nqn.2")
+		Expect(hosts).To(HaveLen(1))
+		Expect(*hosts[0].Name).To(Equal("node-2"))
+
+		Expect(informer.VolumeMappings().ByIndex(sdsaasv2.IndexHostID, "h1")).To(HaveLen(2))
+		Expect(informer.VolumeMappings().ByIndex(sdsaasv2.IndexHostID, "h2")).To(HaveLen(1))
+
+		snapshots := informer.Snapshots().ByIndex(sdsaasv2.IndexSourceVolumeID, "v1")
+		Expect(snapshots).To(HaveLen(1))
+		Expect(*snapshots[0].Name).To(Equal("nightly"))
+		Expect(informer.Snapshots().ByIndex(sdsaasv2.IndexName, "manual")).To(HaveLen(1))
+
+		// Changes are applied to the cache and its indexes.
+		mutex.Lock()
+		volumeName = "archive"
+		mutex.Unlock()
+		Eventually(func() int { return len(informer.Volumes().ByIndex(sdsaasv2.IndexName, "archive")) }).Should(Equal(1))
+		Expect(informer.Volumes().ByIndex(sdsaasv2.IndexName, "data")).To(BeEmpty())
+		Eventually(func() []string {
+			eventsMutex.Lock()
+			defer eventsMutex.Unlock()
+			return events
+		}).Should(ContainElement("modified v1"))
+	})
+	It(`Reports its status`, func() {
+		mutex.Lock()
+		snapshotsDown = true
+		mutex.Unlock()
+		informer := startInformer()
+		Eventually(func() map[string]error { return informer.Status().LastError }).Should(HaveKey(sdsaasv2.WatchResourceSnapshotConst))
+		status := informer.Status()
+		Expect(status.Synced).To(BeFalse())
+		Expect(status.LastRefresh).To(HaveKey(sdsaasv2.WatchResourceVolumeConst))
+
+		waitCtx, waitCancel := context.WithTimeout(ctx, 20*time.Millisecond)
+		defer waitCancel()
+		Expect(informer.WaitForSync(waitCtx)).ToNot(Succeed())
+
+		mutex.Lock()
+		snapshotsDown = false
+		mutex.Unlock()
+		Expect(informer.WaitForSync(ctx)).To(Succeed())
+		status = informer.Status()
+		Expect(status.Synced).To(BeTrue())
+		Expect(status.LastError).To(BeEmpty())
+		Expect(status.LastRefresh).To(HaveLen(4))
+	})
+})
@@ -0,0 +1,138 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// SdsaasV2API : The operations of the SdsaasV2 service, implemented by *SdsaasV2. Code that depends on this
+// interface, or on one of the interfaces of an area, can be tested with a test double such as sdsaasv2fake.Fake.
+type SdsaasV2API interface {
+	VolumesAPI
+	HostsAPI
+	MappingsAPI
+	SnapshotsAPI
+	ObjectAPI
+}
+
+// The service implements all the operations.
+var _ SdsaasV2API = (*SdsaasV2)(nil)
+
+// VolumesAPI : The volume operations of the SdsaasV2 service.
+type VolumesAPI interface {
+	ListVolumes(listVolumesOptions *ListVolumesOptions) (result *VolumeCollection, response *core.DetailedResponse, err error)
+	ListVolumesWithContext(ctx context.Context, listVolumesOptions *ListVolumesOptions) (result *VolumeCollection, response *core.DetailedResponse, err error)
+
+	CreateVolume(createVolumeOptions *CreateVolumeOptions) (result *VolumeSummary, response *core.DetailedResponse, err error)
+	CreateVolumeWithContext(ctx context.Context, createVolumeOptions *CreateVolumeOptions) (result *VolumeSummary, response *core.DetailedResponse, err error)
+
+	DeleteVolume(deleteVolumeOptions *DeleteVolumeOptions) (response *core.DetailedResponse, err error)
+	DeleteVolumeWithContext(ctx context.Context, deleteVolumeOptions *DeleteVolumeOptions) (response *core.DetailedResponse, err error)
+
+	GetVolume(getVolumeOptions *GetVolumeOptions) (result *Volume, response *core.DetailedResponse, err error)
+	GetVolumeWithContext(ctx context.Context, getVolumeOptions *GetVolumeOptions) (result *Volume, response *core.DetailedResponse, err error)
+
+	UpdateVolume(updateVolumeOptions *UpdateVolumeOptions) (result *Volume, response *core.DetailedResponse, err error)
+	UpdateVolumeWithContext(ctx context.Context, updateVolumeOptions *UpdateVolumeOptions) (result *Volume, response *core.DetailedResponse, err error)
+}
+
+// HostsAPI : The host operations of the SdsaasV2 service.
+type HostsAPI interface {
+	ListHosts(listHostsOptions *ListHostsOptions) (result *HostCollection, response *core.DetailedResponse, err error)
+	ListHostsWithContext(ctx context.Context, listHostsOptions *ListHostsOptions) (result *HostCollection, response *core.DetailedResponse, err error)
+
+	CreateHost(createHostOptions *CreateHostOptions) (result *HostSummary, response *core.DetailedResponse, err error)
+	CreateHostWithContext(ctx context.Context, createHostOptions *CreateHostOptions) (result *HostSummary, response *core.DetailedResponse, err error)
+
+	DeleteHost(deleteHostOptions *DeleteHostOptions) (response *core.DetailedResponse, err error)
+	DeleteHostWithContext(ctx context.Context, deleteHostOptions *DeleteHostOptions) (response *core.DetailedResponse, err error)
+
+	GetHost(getHostOptions *GetHostOptions) (result *Host, response *core.DetailedResponse, err error)
+	GetHostWithContext(ctx context.Context, getHostOptions *GetHostOptions) (result *Host, response *core.DetailedResponse, err error)
+
+	UpdateHost(updateHostOptions *UpdateHostOptions) (result *Host, response *core.DetailedResponse, err error)
+	UpdateHostWithContext(ctx context.Context, updateHostOptions *UpdateHostOptions) (result *Host, response *core.DetailedResponse, err error)
+}
+
+// MappingsAPI : The volume mapping operations of the SdsaasV2 service.
+type MappingsAPI interface {
+	DeleteVolumeMappings(deleteVolumeMappingsOptions *DeleteVolumeMappingsOptions) (response *core.DetailedResponse, err error)
+	DeleteVolumeMappingsWithContext(ctx context.Context, deleteVolumeMappingsOptions *DeleteVolumeMappingsOptions) (response *core.DetailedResponse, err error)
+
+	ListVolumeMappings(listVolumeMappingsOptions *ListVolumeMappingsOptions) (result *VolumeMappingCollection, response *core.DetailedResponse, err error)
+	ListVolumeMappingsWithContext(ctx context.Context, listVolumeMappingsOptions *ListVolumeMappingsOptions) (result *VolumeMappingCollection, response *core.DetailedResponse, err error)
+
+	CreateVolumeMapping(createVolumeMappingOptions *CreateVolumeMappingOptions) (result *VolumeMappingReference, response *core.DetailedResponse, err error)
+	CreateVolumeMappingWithContext(ctx context.Context, createVolumeMappingOptions *CreateVolumeMappingOptions) (result *VolumeMappingReference, response *core.DetailedResponse, err error)
+
+	DeleteVolumeMapping(deleteVolumeMappingOptions *DeleteVolumeMappingOptions) (response *core.DetailedResponse, err error)
+	DeleteVolumeMappingWithContext(ctx context.Context, deleteVolumeMappingOptions *DeleteVolumeMappingOptions) (response *core.DetailedResponse, err error)
+
+	GetVolumeMapping(getVolumeMappingOptions *GetVolumeMappingOptions) (result *VolumeMapping, response *core.DetailedResponse, err error)
+	GetVolumeMappingWithContext(ctx context.Context, getVolumeMappingOptions *GetVolumeMappingOptions) (result *VolumeMapping, response *core.DetailedResponse, err error)
+}
+
+// SnapshotsAPI : The snapshot operations of the SdsaasV2 service.
+type SnapshotsAPI interface {
+	DeleteSnapshots(deleteSnapshotsOptions *DeleteSnapshotsOptions) (response *core.DetailedResponse, err error)
+	DeleteSnapshotsWithContext(ctx context.Context, deleteSnapshotsOptions *DeleteSnapshotsOptions) (response *core.DetailedResponse, err error)
+
+	ListSnapshots(listSnapshotsOptions *ListSnapshotsOptions) (result *SnapshotCollection, response *core.DetailedResponse, err error)
+	ListSnapshotsWithContext(ctx context.Context, listSnapshotsOptions *ListSnapshotsOptions) (result *SnapshotCollection, response *core.DetailedResponse, err error)
+
+	CreateSnapshot(createSnapshotOptions *CreateSnapshotOptions) (result *Snapshot, response *core.DetailedResponse, err error)
+	CreateSnapshotWithContext(ctx context.Context, createSnapshotOptions *CreateSnapshotOptions) (result *Snapshot, response *core.DetailedResponse, err error)
+
+	DeleteSnapshot(deleteSnapshotOptions *DeleteSnapshotOptions) (response *core.DetailedResponse, err error)
+	DeleteSnapshotWithContext(ctx context.Context, deleteSnapshotOptions *DeleteSnapshotOptions) (response *core.DetailedResponse, err error)
+
+	GetSnapshot(getSnapshotOptions *GetSnapshotOptions) (result *Snapshot, response *core.DetailedResponse, err error)
+	GetSnapshotWithContext(ctx context.Context, getSnapshotOptions *GetSnapshotOptions) (result *Snapshot, response *core.DetailedResponse, err error)
+
+	UpdateSnapshot(updateSnapshotOptions *UpdateSnapshotOptions) (result *Snapshot, response *core.DetailedResponse, err error)
+	UpdateSnapshotWithContext(ctx context.Context, updateSnapshotOptions *UpdateSnapshotOptions) (result *Snapshot, response *core.DetailedResponse, err error)
+}
+
+// ObjectAPI : The HMAC credential and certificate operations of the SdsaasV2 service.
+type ObjectAPI interface {
+	ListHmacCredentials(listHmacCredentialsOptions *ListHmacCredentialsOptions) (result *StorageCredResponse, response *core.DetailedResponse, err error)
+	ListHmacCredentialsWithContext(ctx context.Context, listHmacCredentialsOptions *ListHmacCredentialsOptions) (result *StorageCredResponse, response *core.DetailedResponse, err error)
+
+	DeleteHmacCredentials(deleteHmacCredentialsOptions *DeleteHmacCredentialsOptions) (response *core.DetailedResponse, err error)
+	DeleteHmacCredentialsWithContext(ctx context.Context, deleteHmacCredentialsOptions *DeleteHmacCredentialsOptions) (response *core.DetailedResponse, err error)
+
+	CreateHmacCredentials(createHmacCredentialsOptions *CreateHmacCredentialsOptions) (result *AccessKeyResponse, response *core.DetailedResponse, err error)
+	CreateHmacCredentialsWithContext(ctx context.Context, createHmacCredentialsOptions *CreateHmacCredentialsOptions) (result *AccessKeyResponse, response *core.DetailedResponse, err error)
+
+	ListCertificates(listCertificatesOptions *ListCertificatesOptions) (result *CertListResponse, response *core.DetailedResponse, err error)
+	ListCertificatesWithContext(ctx context.Context, listCertificatesOptions *ListCertificatesOptions) (result *CertListResponse, response *core.DetailedResponse, err error)
+
+	DeleteSslCert(deleteSslCertOptions *DeleteSslCertOptions) (response *core.DetailedResponse, err error)
+	DeleteSslCertWithContext(ctx context.Context, deleteSslCertOptions *DeleteSslCertOptions) (response *core.DetailedResponse, err error)
+
+	GetS3SslCertStatus(getS3SslCertStatusOptions *GetS3SslCertStatusOptions) (result *StatusResponse, response *core.DetailedResponse, err error)
+	GetS3SslCertStatusWithContext(ctx context.Context, getS3SslCertStatusOptions *GetS3SslCertStatusOptions) (result *StatusResponse, response *core.DetailedResponse, err error)
+
+	CreateSslCert(createSslCertOptions *CreateSslCertOptions) (result *CertResponse, response *core.DetailedResponse, err error)
+	CreateSslCertWithContext(ctx context.Context, createSslCertOptions *CreateSslCertOptions) (result *CertResponse, response *core.DetailedResponse, err error)
+
+	ReplaceSslCert(replaceSslCertOptions *ReplaceSslCertOptions) (result *CertResponse, response *core.DetailedResponse, err error)
+	ReplaceSslCertWithContext(ctx context.Context, replaceSslCertOptions *ReplaceSslCertOptions) (result *CertResponse, response *core.DetailedResponse, err error)
+}
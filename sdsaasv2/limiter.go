@@ -0,0 +1,341 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+)
+
+// DefaultLimiterOpenTimeout is how long the circuit breaker stays open when LimiterOptions.OpenTimeout is not set.
+const DefaultLimiterOpenTimeout = 30 * time.Second
+
+// Constants associated with the LimiterStats.State property.
+// The state of the circuit breaker.
+const (
+	LimiterStatsStateClosedConst   = "closed"
+	LimiterStatsStateHalfOpenConst = "half_open"
+	LimiterStatsStateOpenConst     = "open"
+)
+
+// ErrCircuitOpen is returned, wrapped, for the requests refused while the circuit breaker is open.
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// LimiterOptions : Options of the client-side limits of a service. Each limit is disabled when its option is 0.
+type LimiterOptions struct {
+	// The sustained number of requests sent per second, enforced with a token bucket.
+	RateLimit float64
+
+	// The number of requests that may be sent at once above the rate limit. Defaults to the rate limit, at least 1.
+	Burst int
+
+	// The maximum number of requests in flight at once; further requests wait for one to complete.
+	MaxInFlight int
+
+	// The number of consecutive failures (network errors, 5xx responses and endpoint_unavailable errors) that
+	// opens the circuit breaker.
+	FailureThreshold int
+
+	// How long the circuit breaker stays open before it lets a trial request through. Defaults to
+	// DefaultLimiterOpenTimeout.
+	OpenTimeout time.Duration
+}
+
+// LimiterStats : A snapshot of the state of the limits of a service.
+type LimiterStats struct {
+	// The state of the circuit breaker.
+	State string
+
+	// When the circuit breaker last opened; the zero time if it never did.
+	OpenedAt time.Time
+
+	// The number of consecutive failures counted by the circuit breaker.
+	ConsecutiveFailures int
+
+	// The number of requests in flight.
+	InFlight int
+
+	// The number of requests sent.
+	Requests int64
+
+	// The number of requests that failed.
+	Failures int64
+
+	// The number of requests refused while the circuit breaker was open.
+	Rejected int64
+
+	// The number of requests that waited for the rate limit.
+	Throttled int64
+}
+
+// limiter : The shared state of the limits of a service.
+type limiter struct {
+	options  LimiterOptions
+	inFlight chan struct{}
+
+	mutex       sync.Mutex
+	tokens      float64
+	refilled    time.Time
+	trialActive bool
+	stats       LimiterStats
+}
+
+func newLimiter(options LimiterOptions) *limiter {
+	if options.Burst <= 0 {
+		options.Burst = max(1, int(math.Ceil(options.RateLimit)))
+	}
+	if options.OpenTimeout <= 0 {
+		options.OpenTimeout = DefaultLimiterOpenTimeout
+	}
+	limiter := &limiter{
+		options:  options,
+		tokens:   float64(options.Burst),
+		refilled: time.Now(),
+		stats:    LimiterStats{State: LimiterStatsStateClosedConst},
+	}
+	if options.MaxInFlight > 0 {
+		limiter.inFlight = make(chan struct{}, options.MaxInFlight)
+	}
+	return limiter
+}
+
+// admit checks the circuit breaker, returning whether the request is the trial request of a half-open breaker.
+func (limiter *limiter) admit() (trial bool, err error) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	if limiter.options.FailureThreshold <= 0 {
+		return
+	}
+	if limiter.stats.State == LimiterStatsStateOpenConst && time.Since(limiter.stats.OpenedAt) >= limiter.options.OpenTimeout {
+		limiter.stats.State = LimiterStatsStateHalfOpenConst
+	}
+	if limiter.stats.State == LimiterStatsStateOpenConst || (limiter.stats.State == LimiterStatsStateHalfOpenConst && limiter.trialActive) {
+		limiter.stats.Rejected++
+		err = core.SDKErrorf(ErrCircuitOpen, "the circuit breaker is open after repeated failures of the service", "circuit-open", common.GetComponentInfo())
+		return
+	}
+	if limiter.stats.State == LimiterStatsStateHalfOpenConst {
+		limiter.trialActive = true
+		trial = true
+	}
+	return
+}
+
+// waitForToken takes a token from the rate limit bucket, waiting for one if it is empty.
+func (limiter *limiter) waitForToken(ctx context.Context) error {
+	if limiter.options.RateLimit <= 0 {
+		return nil
+	}
+	limiter.mutex.Lock()
+	now := time.Now()
+	limiter.tokens = min(float64(limiter.options.Burst), limiter.tokens+now.Sub(limiter.refilled).Seconds()*limiter.options.RateLimit)
+	limiter.refilled = now
+	// The token is taken now, leaving a debt that later requests wait for.
+	limiter.tokens--
+	wait := time.Duration(-limiter.tokens / limiter.options.RateLimit * float64(time.Second))
+	if wait > 0 {
+		limiter.stats.Throttled++
+	}
+	limiter.mutex.Unlock()
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		limiter.mutex.Lock()
+		limiter.tokens++
+		limiter.mutex.Unlock()
+		return ctx.Err()
+	}
+}
+
+// acquire takes an in-flight slot, waiting for one if they are all taken.
+func (limiter *limiter) acquire(ctx context.Context) error {
+	if limiter.inFlight != nil {
+		select {
+		case limiter.inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	limiter.mutex.Lock()
+	limiter.stats.InFlight++
+	limiter.stats.Requests++
+	limiter.mutex.Unlock()
+	return nil
+}
+
+// release frees an in-flight slot.
+func (limiter *limiter) release() {
+	limiter.mutex.Lock()
+	limiter.stats.InFlight--
+	limiter.mutex.Unlock()
+	if limiter.inFlight != nil {
+		<-limiter.inFlight
+	}
+}
+
+// record counts the outcome of a request in the circuit breaker.
+func (limiter *limiter) record(trial bool, failed bool) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	if trial {
+		limiter.trialActive = false
+	}
+	if !failed {
+		limiter.stats.ConsecutiveFailures = 0
+		if limiter.stats.State == LimiterStatsStateHalfOpenConst {
+			limiter.stats.State = LimiterStatsStateClosedConst
+		}
+		return
+	}
+	limiter.stats.Failures++
+	limiter.stats.ConsecutiveFailures++
+	if limiter.options.FailureThreshold > 0 && (trial || limiter.stats.ConsecutiveFailures >= limiter.options.FailureThreshold) &&
+		limiter.stats.State != LimiterStatsStateOpenConst {
+		limiter.stats.State = LimiterStatsStateOpenConst
+		limiter.stats.OpenedAt = time.Now()
+		core.GetLogger().Warn("Circuit breaker opened after %d consecutive failures\n", limiter.stats.ConsecutiveFailures)
+	}
+}
+
+// abandon ends the trial request of a half-open circuit breaker without an outcome.
+func (limiter *limiter) abandon(trial bool) {
+	if trial {
+		limiter.mutex.Lock()
+		limiter.trialActive = false
+		limiter.mutex.Unlock()
+	}
+}
+
+// failed reports whether a response or error counts as a failure of the service.
+func failed(res *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	if res.StatusCode >= 500 {
+		return true
+	}
+	return res.StatusCode >= 400 && slices.Contains(errorCodes(res), ErrorObjectCodeEndpointUnavailableConst)
+}
+
+// limiterTransport : Applies the limits of a service to its requests.
+type limiterTransport struct {
+	limiter *limiter
+	next    http.RoundTripper
+}
+
+// RoundTrip sends the request once the circuit breaker, the rate limit and the in-flight limit allow it.
+func (transport *limiterTransport) RoundTrip(req *http.Request) (res *http.Response, err error) {
+	limiter := transport.limiter
+	trial, err := limiter.admit()
+	if err != nil {
+		return
+	}
+	err = limiter.waitForToken(req.Context())
+	if err == nil {
+		err = limiter.acquire(req.Context())
+	}
+	if err != nil {
+		limiter.abandon(trial)
+		return
+	}
+
+	res, err = transport.next.RoundTrip(req)
+	if err != nil && req.Context().Err() != nil {
+		// A request canceled by its caller says nothing about the service.
+		limiter.abandon(trial)
+	} else {
+		limiter.record(trial, failed(res, err))
+	}
+	if err != nil || res.Body == nil {
+		limiter.release()
+		return
+	}
+	// The request stays in flight until its response body is closed.
+	res.Body = &releasingBody{ReadCloser: res.Body, release: limiter.release}
+	return
+}
+
+func (transport *limiterTransport) position() int {
+	return transportLayerLimiter
+}
+
+func (transport *limiterTransport) unwrap() http.RoundTripper {
+	return transport.next
+}
+
+func (transport *limiterTransport) withNext(next http.RoundTripper) transportLayer {
+	return &limiterTransport{limiter: transport.limiter, next: next}
+}
+
+// releasingBody : A response body that calls release once when it is closed.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (body *releasingBody) Close() error {
+	err := body.ReadCloser.Close()
+	body.once.Do(body.release)
+	return err
+}
+
+// SetLimiter limits the requests of the service with a rate limit, a maximum number of requests in flight and a
+// circuit breaker, or removes the limits when the options are nil. While the circuit breaker is open, requests
+// fail with an error that matches ErrCircuitOpen with errors.Is.
+//
+// The limits apply to each attempt of a request retried by a RetryPolicy, and are shared with the clones of the
+// service made after they are set.
+func (sdsaas *SdsaasV2) SetLimiter(options *LimiterOptions) {
+	if options == nil {
+		sdsaas.setTransportLayer(transportLayerLimiter, nil)
+	} else {
+		sdsaas.setTransportLayer(transportLayerLimiter, &limiterTransport{limiter: newLimiter(*options)})
+	}
+}
+
+// GetLimiterStats returns the state of the limits of the service, or nil if none are set.
+func (sdsaas *SdsaasV2) GetLimiterStats() *LimiterStats {
+	transport, ok := sdsaas.getTransportLayer(transportLayerLimiter).(*limiterTransport)
+	if !ok {
+		return nil
+	}
+	limiter := transport.limiter
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	stats := limiter.stats
+	if stats.State == LimiterStatsStateOpenConst && time.Since(stats.OpenedAt) >= limiter.options.OpenTimeout {
+		stats.State = LimiterStatsStateHalfOpenConst
+	}
+	return &stats
+}
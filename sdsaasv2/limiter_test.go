@@ -0,0 +1,176 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Limiter`, func() {
+	var (
+		testServer    *httptest.Server
+		sdsaasService *sdsaasv2.SdsaasV2
+		status        atomic.Int32
+		requests      atomic.Int32
+		inFlight      atomic.Int32
+		maxInFlight   atomic.Int32
+		delay         time.Duration
+	)
+	BeforeEach(func() {
+		status.Store(200)
+		requests.Store(0)
+		maxInFlight.Store(0)
+		delay = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests.Add(1)
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				seen := maxInFlight.Load()
+				if current <= seen || maxInFlight.CompareAndSwap(seen, current) {
+					break
+				}
+			}
+			time.Sleep(delay)
+
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(int(status.Load()))
+			if status.Load() >= 400 {
+				code := "internal_error"
+				if status.Load() == 404 {
+					code = "endpoint_unavailable"
+				}
+				fmt.Fprintf(res, `{"errors": [{"code": "%s", "message": "failed"}]}`, code)
+				return
+			}
+			fmt.Fprint(res, `{"volumes": []}`)
+		}))
+		var err error
+		sdsaasService, err = sdsaasv2.NewSdsaasV2(&sdsaasv2.SdsaasV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	listVolumes := func() error {
+		_, _, err := sdsaasService.ListVolumes(sdsaasService.NewListVolumesOptions())
+		return err
+	}
+
+	It(`Opens the circuit breaker on consecutive failures`, func() {
+		sdsaasService.SetLimiter(&sdsaasv2.LimiterOptions{FailureThreshold: 3, OpenTimeout: 50 * time.Millisecond})
+		Expect(sdsaasService.GetLimiterStats().State).To(Equal(sdsaasv2.LimiterStatsStateClosedConst))
+
+		status.Store(500)
+		Expect(listVolumes()).ToNot(Succeed())
+		status.Store(404)
+		Expect(listVolumes()).ToNot(Succeed())
+		// A success resets the count.
+		status.Store(200)
+		Expect(listVolumes()).To(Succeed())
+		Expect(sdsaasService.GetLimiterStats().ConsecutiveFailures).To(Equal(0))
+
+		status.Store(503)
+		for range 3 {
+			Expect(listVolumes()).ToNot(Succeed())
+		}
+		stats := sdsaasService.GetLimiterStats()
+		Expect(stats.State).To(Equal(sdsaasv2.LimiterStatsStateOpenConst))
+		Expect(stats.ConsecutiveFailures).To(Equal(3))
+		Expect(stats.Failures).To(Equal(int64(5)))
+
+		err := listVolumes()
+		Expect(errors.Is(err, sdsaasv2.ErrCircuitOpen)).To(BeTrue())
+		Expect(requests.Load()).To(Equal(int32(6)))
+		Expect(sdsaasService.GetLimiterStats().Rejected).To(Equal(int64(1)))
+
+		// A failed trial request opens it again; a successful one closes it.
+		time.Sleep(60 * time.Millisecond)
+		Expect(sdsaasService.GetLimiterStats().State).To(Equal(sdsaasv2.LimiterStatsStateHalfOpenConst))
+		Expect(errors.Is(listVolumes(), sdsaasv2.ErrCircuitOpen)).To(BeFalse())
+		Expect(sdsaasService.GetLimiterStats().State).To(Equal(sdsaasv2.LimiterStatsStateOpenConst))
+		time.Sleep(60 * time.Millisecond)
+		status.Store(200)
+		Expect(listVolumes()).To(Succeed())
+		Expect(sdsaasService.GetLimiterStats().State).To(Equal(sdsaasv2.LimiterStatsStateClosedConst))
+	})
+	It(`Is not retried while open`, func() {
+		policy := sdsaasv2.NewRetryPolicy()
+		policy.MinRetryInterval = time.Millisecond
+		sdsaasService.SetRetryPolicy(policy)
+		sdsaasService.SetLimiter(&sdsaasv2.LimiterOptions{FailureThreshold: 2})
+
+		status.Store(503)
+		err := listVolumes()
+		Expect(errors.Is(err, sdsaasv2.ErrCircuitOpen)).To(BeTrue())
+		Expect(requests.Load()).To(Equal(int32(2)))
+
+		sdsaasService.SetLimiter(nil)
+		Expect(sdsaasService.GetLimiterStats()).To(BeNil())
+		Expect(sdsaasService.GetRetryPolicy()).To(Equal(policy))
+	})
+	It(`Limits the requests in flight`, func() {
+		sdsaasService.SetLimiter(&sdsaasv2.LimiterOptions{MaxInFlight: 2})
+		delay = 20 * time.Millisecond
+
+		var waitGroup sync.WaitGroup
+		for range 6 {
+			waitGroup.Add(1)
+			go func() {
+				defer waitGroup.Done()
+				Expect(listVolumes()).To(Succeed())
+			}()
+		}
+		waitGroup.Wait()
+		Expect(maxInFlight.Load()).To(Equal(int32(2)))
+		stats := sdsaasService.GetLimiterStats()
+		Expect(stats.Requests).To(Equal(int64(6)))
+		Expect(stats.InFlight).To(Equal(0))
+	})
+	It(`Limits the request rate`, func() {
+		sdsaasService.SetLimiter(&sdsaasv2.LimiterOptions{RateLimit: 50, Burst: 1})
+		started := time.Now()
+		for range 4 {
+			Expect(listVolumes()).To(Succeed())
+		}
+		Expect(time.Since(started)).To(BeNumerically(">=", 55*time.Millisecond))
+		Expect(sdsaasService.GetLimiterStats().Throttled).To(Equal(int64(3)))
+
+		// Waiting for the rate limit ends with the context.
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+		defer cancel()
+		listVolumes()
+		_, _, err := sdsaasService.ListVolumesWithContext(ctx, sdsaasService.NewListVolumesOptions())
+		Expect(errors.Is(err, context.DeadlineExceeded)).To(BeTrue())
+	})
+})
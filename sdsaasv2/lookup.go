@@ -0,0 +1,274 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+)
+
+// ErrResourceNotFound is returned, wrapped, by the lookups by name or NQN when no resource matches.
+var ErrResourceNotFound = errors.New("resource not found")
+
+// ErrAmbiguousMatch is matched by the *AmbiguousMatchError returned when several resources match a lookup.
+var ErrAmbiguousMatch = errors.New("ambiguous match")
+
+// AmbiguousMatchError : Returned, wrapped, by the lookups by name or NQN when several resources match.
+type AmbiguousMatchError struct {
+	// The kind of resource, e.g. "volume".
+	Resource string
+
+	// The property looked up, e.g. "name".
+	Property string
+
+	// The value looked up.
+	Value string
+
+	// The IDs of the matching resources.
+	IDs []string
+}
+
+func (ambiguousMatchError *AmbiguousMatchError) Error() string {
+	return fmt.Sprintf("%d %ss have the %s %q: %s", len(ambiguousMatchError.IDs), ambiguousMatchError.Resource,
+		ambiguousMatchError.Property, ambiguousMatchError.Value, strings.Join(ambiguousMatchError.IDs, ", "))
+}
+
+// Is matches ErrAmbiguousMatch.
+func (ambiguousMatchError *AmbiguousMatchError) Is(target error) bool {
+	return target == ErrAmbiguousMatch
+}
+
+// lookupCache : The IDs found by the lookups by name or NQN, each kept for a limited time.
+type lookupCache struct {
+	ttl     time.Duration
+	mutex   sync.Mutex
+	entries map[string]lookupCacheEntry
+}
+
+type lookupCacheEntry struct {
+	id      string
+	expires time.Time
+}
+
+func (cache *lookupCache) get(key string) (id string, ok bool) {
+	if cache == nil {
+		return
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	entry, ok := cache.entries[key]
+	if ok && time.Now().After(entry.expires) {
+		delete(cache.entries, key)
+		return "", false
+	}
+	return entry.id, ok
+}
+
+func (cache *lookupCache) put(key string, id string) {
+	if cache == nil {
+		return
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.entries[key] = lookupCacheEntry{id: id, expires: time.Now().Add(cache.ttl)}
+}
+
+func (cache *lookupCache) remove(key string) {
+	if cache == nil {
+		return
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	delete(cache.entries, key)
+}
+
+// EnableLookupCache keeps the IDs found by GetVolumeByName, GetSnapshotByName, GetHostByName and GetHostByNQN
+// for the time to live, so that repeated lookups only get the resource by ID. A cached ID is dropped when its
+// resource is gone or has been renamed. The cache is shared with the clones of the service made afterwards.
+func (sdsaas *SdsaasV2) EnableLookupCache(ttl time.Duration) {
+	sdsaas.lookupCache = &lookupCache{ttl: ttl, entries: map[string]lookupCacheEntry{}}
+}
+
+// DisableLookupCache stops caching the IDs found by the lookups by name or NQN.
+func (sdsaas *SdsaasV2) DisableLookupCache() {
+	sdsaas.lookupCache = nil
+}
+
+// lookup finds the one resource whose property has the value: by the cached ID if any, else by the IDs returned
+// by resolve. The resource is then fetched by ID with get, and checked with matches.
+func lookup[T any](ctx context.Context, sdsaas *SdsaasV2, resource string, property string, value string,
+	resolve func(ctx context.Context) ([]string, error),
+	get func(ctx context.Context, id string) (*T, *core.DetailedResponse, error),
+	matches func(result *T) bool) (result *T, response *core.DetailedResponse, err error) {
+	if value == "" {
+		err = core.SDKErrorf(nil, fmt.Sprintf("the %s %s must not be empty", resource, property), "empty-lookup-value", common.GetComponentInfo())
+		return
+	}
+	cacheKey := resource + "/" + property + "/" + value
+	if id, ok := sdsaas.lookupCache.get(cacheKey); ok {
+		result, response, err = get(ctx, id)
+		if err == nil && matches(result) {
+			return
+		}
+		if err != nil && (response == nil || response.StatusCode != http.StatusNotFound) {
+			return
+		}
+		sdsaas.lookupCache.remove(cacheKey)
+	}
+
+	ids, err := resolve(ctx)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "lookup-list-error")
+		return nil, nil, err
+	}
+	switch len(ids) {
+	case 0:
+		err = core.SDKErrorf(ErrResourceNotFound, fmt.Sprintf("no %s has the %s %q", resource, property, value), "lookup-not-found", common.GetComponentInfo())
+		return nil, nil, err
+	case 1:
+	default:
+		err = core.SDKErrorf(&AmbiguousMatchError{Resource: resource, Property: property, Value: value, IDs: ids}, "", "lookup-ambiguous", common.GetComponentInfo())
+		return nil, nil, err
+	}
+
+	result, response, err = get(ctx, ids[0])
+	if err == nil {
+		sdsaas.lookupCache.put(cacheKey, ids[0])
+	}
+	return
+}
+
+// collectIDs pages through the items of a list operation and returns the IDs of the matching ones. The items
+// without an ID are skipped, since they cannot be retrieved.
+func collectIDs[T any](ctx context.Context, pager Pager[T], id func(item *T) *string, matches func(item *T) bool) (ids []string, err error) {
+	items, err := NewQuery[T]().Where(matches).Run(ctx, pager)
+	for i := range items {
+		if itemID := id(&items[i]); itemID != nil && *itemID != "" {
+			ids = append(ids, *itemID)
+		}
+	}
+	return
+}
+
+// GetVolumeByName : Get the volume with a name
+// The volume is found with the name filter of ListVolumes, then retrieved with GetVolume. The error matches
+// ErrResourceNotFound when no volume has the name, and ErrAmbiguousMatch when several do.
+func (sdsaas *SdsaasV2) GetVolumeByName(name string) (result *Volume, response *core.DetailedResponse, err error) {
+	result, response, err = sdsaas.GetVolumeByNameWithContext(context.Background(), name)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetVolumeByNameWithContext is an alternate form of the GetVolumeByName method which supports a Context parameter
+func (sdsaas *SdsaasV2) GetVolumeByNameWithContext(ctx context.Context, name string) (result *Volume, response *core.DetailedResponse, err error) {
+	matches := func(volume *Volume) bool { return volume.Name != nil && *volume.Name == name }
+	return lookup(ctx, sdsaas, "volume", "name", name,
+		func(ctx context.Context) ([]string, error) {
+			pager, err := sdsaas.NewVolumesPager(sdsaas.NewListVolumesOptions().SetName(name))
+			if err != nil {
+				return nil, err
+			}
+			return collectIDs(ctx, pager, func(volume *Volume) *string { return volume.ID }, matches)
+		},
+		func(ctx context.Context, id string) (*Volume, *core.DetailedResponse, error) {
+			return sdsaas.GetVolumeWithContext(ctx, sdsaas.NewGetVolumeOptions(id))
+		}, matches)
+}
+
+// GetSnapshotByName : Get the snapshot with a name
+// The snapshot is found with the name filter of ListSnapshots, then retrieved with GetSnapshot. The error matches
+// ErrResourceNotFound when no snapshot has the name, and ErrAmbiguousMatch when several do.
+func (sdsaas *SdsaasV2) GetSnapshotByName(name string) (result *Snapshot, response *core.DetailedResponse, err error) {
+	result, response, err = sdsaas.GetSnapshotByNameWithContext(context.Background(), name)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetSnapshotByNameWithContext is an alternate form of the GetSnapshotByName method which supports a Context
+// parameter
+func (sdsaas *SdsaasV2) GetSnapshotByNameWithContext(ctx context.Context, name string) (result *Snapshot, response *core.DetailedResponse, err error) {
+	matches := func(snapshot *Snapshot) bool { return snapshot.Name != nil && *snapshot.Name == name }
+	return lookup(ctx, sdsaas, "snapshot", "name", name,
+		func(ctx context.Context) ([]string, error) {
+			pager, err := sdsaas.NewSnapshotsPager(sdsaas.NewListSnapshotsOptions().SetName(name))
+			if err != nil {
+				return nil, err
+			}
+			return collectIDs(ctx, pager, func(snapshot *Snapshot) *string { return snapshot.ID }, matches)
+		},
+		func(ctx context.Context, id string) (*Snapshot, *core.DetailedResponse, error) {
+			return sdsaas.GetSnapshotWithContext(ctx, sdsaas.NewGetSnapshotOptions(id))
+		}, matches)
+}
+
+// GetHostByName : Get the host with a name
+// The host is found with the name filter of ListHosts, then retrieved with GetHost. The error matches
+// ErrResourceNotFound when no host has the name, and ErrAmbiguousMatch when several do.
+func (sdsaas *SdsaasV2) GetHostByName(name string) (result *Host, response *core.DetailedResponse, err error) {
+	result, response, err = sdsaas.GetHostByNameWithContext(context.Background(), name)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetHostByNameWithContext is an alternate form of the GetHostByName method which supports a Context parameter
+func (sdsaas *SdsaasV2) GetHostByNameWithContext(ctx context.Context, name string) (result *Host, response *core.DetailedResponse, err error) {
+	matches := func(host *Host) bool { return host.Name != nil && *host.Name == name }
+	return lookup(ctx, sdsaas, "host", "name", name,
+		func(ctx context.Context) ([]string, error) {
+			pager, err := sdsaas.NewHostsPager(sdsaas.NewListHostsOptions().SetName(name))
+			if err != nil {
+				return nil, err
+			}
+			return collectIDs(ctx, pager, func(host *Host) *string { return host.ID }, matches)
+		},
+		func(ctx context.Context, id string) (*Host, *core.DetailedResponse, error) {
+			return sdsaas.GetHostWithContext(ctx, sdsaas.NewGetHostOptions(id))
+		}, matches)
+}
+
+// GetHostByNQN : Get the host with an NQN
+// ListHosts has no NQN filter, so the host is found by paging through every host, then retrieved with GetHost. The
+// error matches ErrResourceNotFound when no host has the NQN, and ErrAmbiguousMatch when several do.
+func (sdsaas *SdsaasV2) GetHostByNQN(nqn string) (result *Host, response *core.DetailedResponse, err error) {
+	result, response, err = sdsaas.GetHostByNQNWithContext(context.Background(), nqn)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetHostByNQNWithContext is an alternate form of the GetHostByNQN method which supports a Context parameter
+func (sdsaas *SdsaasV2) GetHostByNQNWithContext(ctx context.Context, nqn string) (result *Host, response *core.DetailedResponse, err error) {
+	matches := func(host *Host) bool { return host.Nqn != nil && *host.Nqn == nqn }
+	return lookup(ctx, sdsaas, "host", "NQN", nqn,
+		func(ctx context.Context) ([]string, error) {
+			pager, err := sdsaas.NewHostsPager(sdsaas.NewListHostsOptions())
+			if err != nil {
+				return nil, err
+			}
+			return collectIDs(ctx, pager, func(host *Host) *string { return host.ID }, matches)
+		},
+		func(ctx context.Context, id string) (*Host, *core.DetailedResponse, error) {
+			return sdsaas.GetHostWithContext(ctx, sdsaas.NewGetHostOptions(id))
+		}, matches)
+}
@@ -0,0 +1,180 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Lookups by name and NQN`, func() {
+	var (
+		testServer    *httptest.Server
+		sdsaasService *sdsaasv2.SdsaasV2
+		requests      []string
+		resources     map[string][]map[string]string
+	)
+	BeforeEach(func() {
+		requests = nil
+		resources = map[string][]map[string]string{
+			"volumes": {
+				{"id": "v1", "name": "data"},
+				{"id": "v2", "name": "data-old"},
+				{"id": "v3", "name": "logs"},
+			},
+			"snapshots": {
+				{"id": "s1", "name": "nightly"},
+				{"id": "s2", "name": "nightly"},
+				{"name": "weekly"},
+				{"id": "s3", "name": "weekly"},
+			},
+			"hosts": {
+				{"id": "h1", "name": "node-1", "nqn": "nqn.2014-08.org.nvmexpress:uuid:1"},
+				{"id": "h2", "name": "node-2", "nqn": "nqn.2014-08.org.nvmexpress:uuid:2"},
+				{"id": "h3", "name": "node-3", "nqn": "nqn.2014-08.org.nvmexpress:uuid:3"},
+			},
+		}
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			requests = append(requests, req.URL.RequestURI())
+			res.Header().Set("Content-type", "application/json")
+			parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+			if len(parts) == 2 {
+				for _, resource := range resources[parts[0]] {
+					if resource["id"] == parts[1] {
+						res.WriteHeader(200)
+						fmt.Fprintf(res, `{"id": "%s", "name": "%s", "nqn": "%s"}`, resource["id"], resource["name"], resource["nqn"])
+						return
+					}
+				}
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"code": "not_found", "message": "not found"}]}`)
+				return
+			}
+
+			// The name filter matches prefixes; the hosts are listed one per page.
+			var items []string
+			for _, resource := range resources[parts[0]] {
+				if strings.HasPrefix(resource["name"], req.URL.Query().Get("name")) {
+					item := fmt.Sprintf(`"name": "%s", "nqn": "%s"`, resource["name"], resource["nqn"])
+					if resource["id"] != "" {
+						item = fmt.Sprintf(`"id": "%s", %s`, resource["id"], item)
+					}
+					items = append(items, "{"+item+"}")
+				}
+			}
+			next := ""
+			if parts[0] == "hosts" {
+				start := 0
+				fmt.Sscanf(req.URL.Query().Get("start"), "%d", &start)
+				if start+1 < len(items) {
+					next = fmt.Sprintf(`, "next": {"href": "%s/hosts?start=%d"}`, testServer.URL, start+1)
+				}
+				items = items[start : start+1]
+			}
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"%s": [%s]%s}`, parts[0], strings.Join(items, ","), next)
+		}))
+		var err error
+		sdsaasService, err = sdsaasv2.NewSdsaasV2(&sdsaasv2.SdsaasV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Finds volumes by exact name with the name filter`, func() {
+		volume, response, err := sdsaasService.GetVolumeByName("data")
+		Expect(err).To(BeNil())
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(*volume.ID).To(Equal("v1"))
+		Expect(requests).To(Equal([]string{"/volumes?name=data", "/volumes/v1"}))
+
+		_, _, err = sdsaasService.GetVolumeByName("missing")
+		Expect(errors.Is(err, sdsaasv2.ErrResourceNotFound)).To(BeTrue())
+		_, _, err = sdsaasService.GetVolumeByName("")
+		Expect(err).ToNot(BeNil())
+	})
+	It(`Reports ambiguous names`, func() {
+		_, _, err := sdsaasService.GetSnapshotByName("nightly")
+		Expect(errors.Is(err, sdsaasv2.ErrAmbiguousMatch)).To(BeTrue())
+		var ambiguousMatchError *sdsaasv2.AmbiguousMatchError
+		Expect(errors.As(err, &ambiguousMatchError)).To(BeTrue())
+		Expect(ambiguousMatchError.IDs).To(Equal([]string{"s1", "s2"}))
+		Expect(err.Error()).To(ContainSubstring(`2 snapshots have the name "nightly": s1, s2`))
+	})
+	It(`Skips the listed resources without an ID`, func() {
+		snapshot, _, err := sdsaasService.GetSnapshotByName("weekly")
+		Expect(err).To(BeNil())
+		Expect(*snapshot.ID).To(Equal("s3"))
+		Expect(requests).To(Equal([]string{"/snapshots?name=weekly", "/snapshots/s3"}))
+	})
+	It(`Finds hosts by name and by NQN`, func() {
+		host, _, err := sdsaasService.GetHostByName("node-2")
+		Expect(err).To(BeNil())
+		Expect(*host.ID).To(Equal("h2"))
+
+		requests = nil
+		host, _, err = sdsaasService.GetHostByNQN("nqn.2014-08.org.nvmexpress:uuid:3")
+		Expect(err).To(BeNil())
+		Expect(*host.ID).To(Equal("h3"))
+		Expect(requests).To(Equal([]string{"/hosts", "/hosts?start=1", "/hosts?start=2", "/hosts/h3"}))
+	})
+	It(`Caches the IDs found`, func() {
+		sdsaasService.EnableLookupCache(time.Minute)
+		for range 2 {
+			_, _, err := sdsaasService.GetVolumeByName("logs")
+			Expect(err).To(BeNil())
+		}
+		Expect(requests).To(Equal([]string{"/volumes?name=logs", "/volumes/v3", "/volumes/v3"}))
+
+		// A renamed volume is looked up again.
+		resources["volumes"][2]["name"] = "logs-old"
+		resources["volumes"] = append(resources["volumes"], map[string]string{"id": "v4", "name": "logs"})
+		requests = nil
+		volume, _, err := sdsaasService.GetVolumeByName("logs")
+		Expect(err).To(BeNil())
+		Expect(*volume.ID).To(Equal("v4"))
+		Expect(requests).To(Equal([]string{"/volumes/v3", "/volumes?name=logs", "/volumes/v4"}))
+
+		// So is a deleted one.
+		resources["volumes"] = resources["volumes"][:3]
+		requests = nil
+		_, _, err = sdsaasService.GetVolumeByName("logs")
+		Expect(errors.Is(err, sdsaasv2.ErrResourceNotFound)).To(BeTrue())
+		Expect(requests).To(Equal([]string{"/volumes/v4", "/volumes?name=logs"}))
+
+		sdsaasService.DisableLookupCache()
+		requests = nil
+		_, _, err = sdsaasService.GetVolumeByName("data")
+		Expect(err).To(BeNil())
+		_, _, err = sdsaasService.GetVolumeByName("data")
+		Expect(err).To(BeNil())
+		Expect(requests).To(HaveLen(4))
+	})
+})
@@ -0,0 +1,78 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Forward-compatible models`, func() {
+	var (
+		testServer    *httptest.Server
+		sdsaasService *sdsaasv2.SdsaasV2
+	)
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			fmt.Fprint(res, `{"id": "v1", "name": "data", "status": "migrating", "encryption": {"key_id": "k1"},
+				"volume_mappings": [{"id": "m1", "status": "mapped", "transport": "nvme-tcp"}]}`)
+		}))
+		var err error
+		sdsaasService, err = sdsaasv2.NewSdsaasV2(&sdsaasv2.SdsaasV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Preserves unknown properties and enumerated values`, func() {
+		volume, _, err := sdsaasService.GetVolume(sdsaasService.NewGetVolumeOptions("v1"))
+		Expect(err).To(BeNil())
+		Expect(*volume.Status).To(Equal(sdsaasv2.VolumeStatus("migrating")))
+		Expect(volume.Status.IsKnown()).To(BeFalse())
+		Expect(volume.VolumeMappings[0].Status.IsKnown()).To(BeTrue())
+		Expect(volume.GetProperty("encryption")).To(Equal(map[string]interface{}{"key_id": "k1"}))
+		Expect(volume.GetProperties()).To(HaveLen(1))
+		Expect(volume.VolumeMappings[0].GetProperty("transport")).To(Equal("nvme-tcp"))
+
+		data, err := json.Marshal(volume)
+		Expect(err).To(BeNil())
+		Expect(data).To(MatchJSON(`{"id": "v1", "name": "data", "status": "migrating", "encryption": {"key_id": "k1"},
+			"volume_mappings": [{"id": "m1", "status": "mapped", "transport": "nvme-tcp"}]}`))
+	})
+	It(`Sends arbitrary properties in patches`, func() {
+		volumePatch := &sdsaasv2.VolumePatch{Name: core.StringPtr("data")}
+		volumePatch.SetProperty("volume_group", "group-1")
+		patch, err := volumePatch.AsPatch()
+		Expect(err).To(BeNil())
+		Expect(patch).To(Equal(map[string]interface{}{"name": core.StringPtr("data"), "volume_group": "group-1"}))
+
+		Expect(sdsaasv2.VolumeStatusPendingDeletionConst.IsKnown()).To(BeTrue())
+		Expect(sdsaasv2.VolumeMappingStatus("unmapped").IsKnown()).To(BeFalse())
+	})
+})
@@ -0,0 +1,435 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"slices"
+	"strconv"
+	"text/template"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+)
+
+// Defaults of a Notifier.
+const (
+	DefaultNotifierMaxRetries    = 3
+	DefaultNotifierRetryInterval = time.Second
+)
+
+// Headers of the requests sent by a WebhookSink.
+const (
+	// HeaderNameWebhookTimestamp holds the Unix time at which the request was signed.
+	HeaderNameWebhookTimestamp = "X-Sds-Timestamp"
+
+	// HeaderNameWebhookSignature holds "sha256=" and the hex HMAC-SHA256 of the timestamp, a dot and the body.
+	HeaderNameWebhookSignature = "X-Sds-Signature"
+)
+
+// ErrPermanentNotificationFailure is matched by the errors of the deliveries that cannot succeed when retried,
+// e.g. when a webhook answers with a 4xx status other than 408 or 429. A Notifier does not retry them; a
+// NotificationSink may wrap it in its own errors.
+var ErrPermanentNotificationFailure = errors.New("permanent notification failure")
+
+// NotificationRule : Selects the status transitions of a kind of resource that are notified.
+type NotificationRule struct {
+	// The name of the rule, included in the notifications.
+	Name string
+
+	// The kind of resource, e.g. WatchResourceVolumeConst.
+	Resource string
+
+	// Only notify transitions from this status; any status when empty. A resource being added has no previous
+	// status, and is only notified with NotifierOptions.NotifyAdded.
+	FromStatus string
+
+	// Only notify transitions to this status; any status when empty. A resource being deleted has no status.
+	ToStatus string
+
+	// A text/template rendering the JSON payload, with a NotificationData as data and a "json" function that
+	// encodes a value as JSON. The default payload is a NotificationData.
+	Template string
+
+	// The names of the sinks the notifications are sent to; all sinks when empty.
+	Sinks []string
+}
+
+// NotificationData : The data of a notification, and its default payload.
+type NotificationData struct {
+	// The name of the rule.
+	Rule string `json:"rule"`
+
+	// The kind of resource.
+	Resource string `json:"resource"`
+
+	// The ID of the resource.
+	ID string `json:"id"`
+
+	// The name of the resource, if it has one.
+	Name string `json:"name,omitempty"`
+
+	// The previous status; empty for an added resource.
+	FromStatus string `json:"from_status,omitempty"`
+
+	// The new status; empty for a deleted resource.
+	ToStatus string `json:"to_status,omitempty"`
+
+	// A sentence describing the transition, shown by chat webhooks such as Slack's.
+	Text string `json:"text"`
+
+	// The time of the transition.
+	Time time.Time `json:"time"`
+
+	// The resource: a *Volume, *Host, *VolumeMapping or *Snapshot.
+	Object interface{} `json:"object,omitempty"`
+}
+
+// Notification : A notification sent to a NotificationSink.
+type Notification struct {
+	// The data of the notification.
+	Data *NotificationData
+
+	// The JSON payload rendered by the rule.
+	Payload []byte
+}
+
+// NotificationSink : Delivers notifications, e.g. a WebhookSink.
+type NotificationSink interface {
+	// Send delivers a notification. A Notifier retries the failed deliveries, unless the error matches
+	// ErrPermanentNotificationFailure.
+	Send(ctx context.Context, notification *Notification) error
+}
+
+// NotificationSinkFunc : A function used as a NotificationSink.
+type NotificationSinkFunc func(ctx context.Context, notification *Notification) error
+
+// Send calls the function.
+func (sinkFunc NotificationSinkFunc) Send(ctx context.Context, notification *Notification) error {
+	return sinkFunc(ctx, notification)
+}
+
+// WebhookSink : A NotificationSink that posts the payloads to an HTTP webhook, signed with HMAC-SHA256 when it
+// has a secret.
+type WebhookSink struct {
+	// The URL of the webhook.
+	URL string
+
+	// The key of the signatures. Requests are not signed when empty.
+	Secret []byte
+
+	// Additional request headers.
+	Headers map[string]string
+
+	// The client used to post; http.DefaultClient when nil.
+	Client *http.Client
+}
+
+// NewWebhookSink : constructs a WebhookSink.
+func NewWebhookSink(url string, secret []byte) *WebhookSink {
+	return &WebhookSink{URL: url, Secret: secret}
+}
+
+// Send posts the payload, failing unless the webhook answers with a 2xx status. The error matches
+// ErrPermanentNotificationFailure when the status is a 4xx other than 408 (Request Timeout) and 429 (Too Many
+// Requests).
+func (sink *WebhookSink) Send(ctx context.Context, notification *Notification) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sink.URL, bytes.NewReader(notification.Payload))
+	if err != nil {
+		return core.SDKErrorf(err, "", "webhook-request-error", common.GetComponentInfo())
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", common.GetUserAgentInfo())
+	for name, value := range sink.Headers {
+		req.Header.Set(name, value)
+	}
+	if len(sink.Secret) > 0 {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(HeaderNameWebhookTimestamp, timestamp)
+		req.Header.Set(HeaderNameWebhookSignature, SignWebhookPayload(sink.Secret, timestamp, notification.Payload))
+	}
+
+	client := sink.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return core.SDKErrorf(err, "", "webhook-send-error", common.GetComponentInfo())
+	}
+	defer res.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(res.Body, 1<<20))
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		message := fmt.Sprintf("the webhook answered with status %d", res.StatusCode)
+		if res.StatusCode >= 400 && res.StatusCode <= 499 && res.StatusCode != http.StatusRequestTimeout && res.StatusCode != http.StatusTooManyRequests {
+			return core.SDKErrorf(fmt.Errorf("%w: %s", ErrPermanentNotificationFailure, message), message, "webhook-status-error", common.GetComponentInfo())
+		}
+		return core.SDKErrorf(nil, message, "webhook-status-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// SignWebhookPayload returns the signature of a payload sent by a WebhookSink at a Unix timestamp.
+func SignWebhookPayload(secret []byte, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyWebhookSignature reports whether a signature received by a webhook is valid for its timestamp and payload.
+func VerifyWebhookSignature(secret []byte, timestamp string, payload []byte, signature string) bool {
+	return hmac.Equal([]byte(SignWebhookPayload(secret, timestamp, payload)), []byte(signature))
+}
+
+// NotifierOptions : Options for a Notifier.
+type NotifierOptions struct {
+	// The transitions that are notified.
+	Rules []NotificationRule
+
+	// The sinks, by name.
+	Sinks map[string]NotificationSink
+
+	// When set to true, the added resources are notified as transitions from no status. Off by default, since a
+	// watch reports every existing resource as added when it starts.
+	NotifyAdded bool
+
+	// The maximum number of retries of a failed delivery. Defaults to DefaultNotifierMaxRetries; no retries when
+	// negative.
+	MaxRetries int
+
+	// The wait before the first retry, doubled before each further retry. Defaults to
+	// DefaultNotifierRetryInterval.
+	RetryInterval time.Duration
+
+	// Called by Run with the errors of the deliveries that failed after every retry.
+	OnError func(err error)
+}
+
+// Notifier : Sends notifications of the status transitions of resources, observed by a watch (see Watch and
+// Informer.AddEventHandler), to webhooks and other sinks.
+type Notifier struct {
+	options   NotifierOptions
+	templates []*template.Template
+}
+
+// NewNotifier : constructs a Notifier, checking its rules and templates.
+func NewNotifier(options *NotifierOptions) (notifier *Notifier, err error) {
+	err = core.ValidateNotNil(options, "options cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	notifier = &Notifier{options: *options}
+	if notifier.options.MaxRetries == 0 {
+		notifier.options.MaxRetries = DefaultNotifierMaxRetries
+	}
+	if notifier.options.RetryInterval <= 0 {
+		notifier.options.RetryInterval = DefaultNotifierRetryInterval
+	}
+
+	notifier.templates = make([]*template.Template, len(options.Rules))
+	for i, rule := range options.Rules {
+		for _, sink := range rule.Sinks {
+			if options.Sinks[sink] == nil {
+				err = core.SDKErrorf(nil, fmt.Sprintf("the rule %q refers to the unknown sink %q", rule.Name, sink), "unknown-notification-sink", common.GetComponentInfo())
+				return nil, err
+			}
+		}
+		if rule.Template == "" {
+			continue
+		}
+		notifier.templates[i], err = template.New(rule.Name).Funcs(template.FuncMap{"json": templateJSON}).Parse(rule.Template)
+		if err != nil {
+			err = core.SDKErrorf(err, fmt.Sprintf("the template of the rule %q is not valid", rule.Name), "notification-template-error", common.GetComponentInfo())
+			return nil, err
+		}
+	}
+	return
+}
+
+func templateJSON(value interface{}) (string, error) {
+	data, err := json.Marshal(value)
+	return string(data), err
+}
+
+// Run notifies the events received from the channel until it is closed or the context is cancelled.
+func (notifier *Notifier) Run(ctx context.Context, events <-chan WatchEvent) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			err := notifier.Notify(ctx, &event)
+			if err != nil && notifier.options.OnError != nil {
+				notifier.options.OnError(err)
+			}
+		}
+	}
+}
+
+// Notify sends the notifications of every rule that matches an event, and returns the errors of the deliveries
+// that failed after every retry.
+func (notifier *Notifier) Notify(ctx context.Context, event *WatchEvent) error {
+	if event.Type == WatchEventTypeErrorConst || event.Resync || (event.Type == WatchEventTypeAddedConst && !notifier.options.NotifyAdded) {
+		return nil
+	}
+	fromStatus, toStatus := resourceStatus(event.OldObject), resourceStatus(event.Object)
+	if event.Type == WatchEventTypeDeletedConst {
+		fromStatus, toStatus = toStatus, ""
+	}
+	if fromStatus == toStatus {
+		return nil
+	}
+
+	var errs []error
+	for i, rule := range notifier.options.Rules {
+		if rule.Resource != event.Resource || (rule.FromStatus != "" && rule.FromStatus != fromStatus) || (rule.ToStatus != "" && rule.ToStatus != toStatus) {
+			continue
+		}
+		data := &NotificationData{
+			Rule:       rule.Name,
+			Resource:   event.Resource,
+			ID:         event.ID,
+			Name:       resourceName(event.Object),
+			FromStatus: fromStatus,
+			ToStatus:   toStatus,
+			Time:       event.Time,
+			Object:     event.Object,
+		}
+		data.Text = notificationText(data)
+		notification, err := notifier.render(notifier.templates[i], data)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, name := range slices.Sorted(maps.Keys(notifier.options.Sinks)) {
+			if len(rule.Sinks) == 0 || slices.Contains(rule.Sinks, name) {
+				if err := notifier.send(ctx, notifier.options.Sinks[name], notification); err != nil {
+					errs = append(errs, core.SDKErrorf(err, fmt.Sprintf("the notification of the rule %q could not be sent to %q: %s", rule.Name, name, err.Error()), "notification-send-error", common.GetComponentInfo()))
+				}
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// render returns the notification of the data, with the payload rendered by the template, if any.
+func (notifier *Notifier) render(tmpl *template.Template, data *NotificationData) (*Notification, error) {
+	notification := &Notification{Data: data}
+	if tmpl == nil {
+		payload, err := json.Marshal(data)
+		if err != nil {
+			return nil, core.SDKErrorf(err, "", "notification-marshal-error", common.GetComponentInfo())
+		}
+		notification.Payload = payload
+		return notification, nil
+	}
+
+	var payload bytes.Buffer
+	if err := tmpl.Execute(&payload, data); err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("the template of the rule %q failed", data.Rule), "notification-template-error", common.GetComponentInfo())
+	}
+	if !json.Valid(payload.Bytes()) {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("the template of the rule %q did not render valid JSON", data.Rule), "notification-template-error", common.GetComponentInfo())
+	}
+	notification.Payload = payload.Bytes()
+	return notification, nil
+}
+
+// send delivers a notification to a sink, retrying the failed deliveries unless they failed for good.
+func (notifier *Notifier) send(ctx context.Context, sink NotificationSink, notification *Notification) (err error) {
+	wait := notifier.options.RetryInterval
+	for attempt := 0; ; attempt++ {
+		err = sink.Send(ctx, notification)
+		if err == nil || errors.Is(err, ErrPermanentNotificationFailure) || attempt >= notifier.options.MaxRetries {
+			return
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		wait *= 2
+	}
+}
+
+// resourceStatus returns the status of a resource: the status of a volume or volume mapping, the lifecycle state of
+// a snapshot, or "" for anything else.
+func resourceStatus(object interface{}) string {
+	switch object := object.(type) {
+	case *Volume:
+		if object.Status != nil {
+			return string(*object.Status)
+		}
+	case *VolumeMapping:
+		if object.Status != nil {
+			return string(*object.Status)
+		}
+	case *Snapshot:
+		return core.StringNilMapper(object.LifecycleState)
+	}
+	return ""
+}
+
+// resourceName returns the name of a resource, or for a volume mapping the names of its volume and host.
+func resourceName(object interface{}) string {
+	switch object := object.(type) {
+	case *Volume:
+		return core.StringNilMapper(object.Name)
+	case *Host:
+		return core.StringNilMapper(object.Name)
+	case *Snapshot:
+		return core.StringNilMapper(object.Name)
+	case *VolumeMapping:
+		if object.Volume != nil && object.Host != nil && object.Volume.Name != nil && object.Host.Name != nil {
+			return *object.Volume.Name + " on " + *object.Host.Name
+		}
+	}
+	return ""
+}
+
+func notificationText(data *NotificationData) string {
+	subject := data.Resource + " " + data.ID
+	if data.Name != "" {
+		subject = fmt.Sprintf("%s %s (%s)", data.Resource, data.Name, data.ID)
+	}
+	switch {
+	case data.FromStatus == "":
+		return fmt.Sprintf("%s was added with status %s", subject, data.ToStatus)
+	case data.ToStatus == "":
+		return fmt.Sprintf("%s was deleted with status %s", subject, data.FromStatus)
+	default:
+		return fmt.Sprintf("%s changed status from %s to %s", subject, data.FromStatus, data.ToStatus)
+	}
+}
@@ -0,0 +1,188 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Notifier`, func() {
+	var (
+		testServer *httptest.Server
+		mutex      sync.Mutex
+		payloads   []map[string]interface{}
+		failures   int
+		requests   int
+		secret     = []byte("webhook-secret")
+	)
+	volumeEvent := func(eventType string, oldStatus sdsaasv2.VolumeStatus, status sdsaasv2.VolumeStatus) *sdsaasv2.WatchEvent {
+		event := &sdsaasv2.WatchEvent{Type: eventType, Resource: sdsaasv2.WatchResourceVolumeConst, ID: "v1", Time: time.Now()}
+		if status != "" {
+			event.Object = &sdsaasv2.Volume{ID: core.StringPtr("v1"), Name: core.StringPtr("db"), Status: &status}
+		}
+		if oldStatus != "" {
+			event.OldObject = &sdsaasv2.Volume{ID: core.StringPtr("v1"), Name: core.StringPtr("db"), Status: &oldStatus}
+		}
+		return event
+	}
+	BeforeEach(func() {
+		payloads = nil
+		failures = 0
+		requests = 0
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			requests++
+			body, _ := io.ReadAll(req.Body)
+			if !sdsaasv2.VerifyWebhookSignature(secret, req.Header.Get(sdsaasv2.HeaderNameWebhookTimestamp), body, req.Header.Get(sdsaasv2.HeaderNameWebhookSignature)) {
+				res.WriteHeader(401)
+				return
+			}
+			if failures > 0 {
+				failures--
+				res.WriteHeader(500)
+				return
+			}
+			var payload map[string]interface{}
+			Expect(json.Unmarshal(body, &payload)).To(Succeed())
+			payloads = append(payloads, payload)
+			res.WriteHeader(204)
+		}))
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+	It(`Notify volumes leaving "available" to a signed webhook`, func() {
+		notifier, err := sdsaasv2.NewNotifier(&sdsaasv2.NotifierOptions{
+			Rules: []sdsaasv2.NotificationRule{{
+				Name: "volume-unavailable", Resource: sdsaasv2.WatchResourceVolumeConst, FromStatus: "available",
+			}},
+			Sinks: map[string]sdsaasv2.NotificationSink{"ops": sdsaasv2.NewWebhookSink(testServer.URL, secret)},
+		})
+		Expect(err).To(BeNil())
+
+		Expect(notifier.Notify(context.Background(), volumeEvent(sdsaasv2.WatchEventTypeAddedConst, "", "available"))).To(Succeed())
+		Expect(notifier.Notify(context.Background(), volumeEvent(sdsaasv2.WatchEventTypeModifiedConst, "available", "updating"))).To(Succeed())
+		Expect(notifier.Notify(context.Background(), volumeEvent(sdsaasv2.WatchEventTypeModifiedConst, "updating", "available"))).To(Succeed())
+		Expect(notifier.Notify(context.Background(), volumeEvent(sdsaasv2.WatchEventTypeDeletedConst, "", "available"))).To(Succeed())
+
+		Expect(payloads).To(HaveLen(2))
+		Expect(payloads[0]).To(HaveKeyWithValue("rule", "volume-unavailable"))
+		Expect(payloads[0]).To(HaveKeyWithValue("from_status", "available"))
+		Expect(payloads[0]).To(HaveKeyWithValue("to_status", "updating"))
+		Expect(payloads[0]).To(HaveKeyWithValue("text", "volume db (v1) changed status from available to updating"))
+		Expect(payloads[1]).ToNot(HaveKey("to_status"))
+		Expect(payloads[1]).To(HaveKeyWithValue("text", "volume db (v1) was deleted with status available"))
+
+		sink := sdsaasv2.NewWebhookSink(testServer.URL, []byte("wrong-secret"))
+		err = sink.Send(context.Background(), &sdsaasv2.Notification{Payload: []byte(`{}`)})
+		Expect(errors.Is(err, sdsaasv2.ErrPermanentNotificationFailure)).To(BeTrue())
+	})
+	It(`Skip added resources unless enabled and stop retrying permanent failures`, func() {
+		notifier, err := sdsaasv2.NewNotifier(&sdsaasv2.NotifierOptions{
+			Rules: []sdsaasv2.NotificationRule{{Name: "volume-status", Resource: sdsaasv2.WatchResourceVolumeConst}},
+			Sinks: map[string]sdsaasv2.NotificationSink{
+				"ops":    sdsaasv2.NewWebhookSink(testServer.URL, secret),
+				"signed": sdsaasv2.NewWebhookSink(testServer.URL, []byte("wrong-secret")),
+			},
+			RetryInterval: time.Millisecond,
+		})
+		Expect(err).To(BeNil())
+
+		// The volumes listed when a watch starts are not notified.
+		Expect(notifier.Notify(context.Background(), volumeEvent(sdsaasv2.WatchEventTypeAddedConst, "", "available"))).To(Succeed())
+		Expect(requests).To(BeZero())
+
+		// The 401 of the wrongly signed sink is not retried.
+		err = notifier.Notify(context.Background(), volumeEvent(sdsaasv2.WatchEventTypeModifiedConst, "available", "updating"))
+		Expect(errors.Is(err, sdsaasv2.ErrPermanentNotificationFailure)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("status 401"))
+		Expect(payloads).To(HaveLen(1))
+		Expect(requests).To(Equal(2))
+	})
+	It(`Render mapping failures with a template and retry failed deliveries`, func() {
+		var errs []error
+		notifier, err := sdsaasv2.NewNotifier(&sdsaasv2.NotifierOptions{
+			Rules: []sdsaasv2.NotificationRule{{
+				Name: "mapping-failed", Resource: sdsaasv2.WatchResourceVolumeMappingConst, ToStatus: "mapping_failed",
+				Template: `{"text": {{ json .Text }}, "mapping": {{ json .Object.ID }}}`,
+			}},
+			Sinks:         map[string]sdsaasv2.NotificationSink{"slack": sdsaasv2.NewWebhookSink(testServer.URL, secret)},
+			NotifyAdded:   true,
+			RetryInterval: time.Millisecond,
+			OnError: func(err error) {
+				mutex.Lock()
+				defer mutex.Unlock()
+				errs = append(errs, err)
+			},
+		})
+		Expect(err).To(BeNil())
+
+		status := sdsaasv2.VolumeMappingStatusMappingFailedConst
+		mapping := &sdsaasv2.VolumeMapping{
+			ID:     core.StringPtr("m1"),
+			Status: &status,
+			Volume: &sdsaasv2.VolumeReference{Name: core.StringPtr("db")},
+			Host:   &sdsaasv2.HostReference{Name: core.StringPtr("node-1")},
+		}
+		events := make(chan sdsaasv2.WatchEvent, 2)
+		events <- sdsaasv2.WatchEvent{Type: sdsaasv2.WatchEventTypeAddedConst, Resource: sdsaasv2.WatchResourceVolumeMappingConst, ID: "m1", Object: mapping}
+		events <- sdsaasv2.WatchEvent{Type: sdsaasv2.WatchEventTypeAddedConst, Resource: sdsaasv2.WatchResourceVolumeMappingConst, ID: "m1", Object: mapping}
+		close(events)
+		failures = 2
+		go func() {
+			defer GinkgoRecover()
+			Expect(notifier.Run(context.Background(), events)).To(Succeed())
+		}()
+		Eventually(func() int {
+			mutex.Lock()
+			defer mutex.Unlock()
+			return len(payloads)
+		}).Should(Equal(2))
+		mutex.Lock()
+		Expect(payloads[0]).To(Equal(map[string]interface{}{
+			"text":    "volume_mapping db on node-1 (m1) was added with status mapping_failed",
+			"mapping": "m1",
+		}))
+		Expect(errs).To(BeEmpty())
+		failures = 10
+		mutex.Unlock()
+		err = notifier.Notify(context.Background(), &sdsaasv2.WatchEvent{Type: sdsaasv2.WatchEventTypeAddedConst, Resource: sdsaasv2.WatchResourceVolumeMappingConst, ID: "m1", Object: mapping})
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("status 500"))
+	})
+	It(`Reject invalid rules`, func() {
+		_, err := sdsaasv2.NewNotifier(nil)
+		Expect(err).ToNot(BeNil())
+		_, err = sdsaasv2.NewNotifier(&sdsaasv2.NotifierOptions{Rules: []sdsaasv2.NotificationRule{{Name: "r", Sinks: []string{"missing"}}}})
+		Expect(err).ToNot(BeNil())
+		_, err = sdsaasv2.NewNotifier(&sdsaasv2.NotifierOptions{Rules: []sdsaasv2.NotificationRule{{Name: "r", Template: "{{"}}})
+		Expect(err).ToNot(BeNil())
+	})
+})
@@ -0,0 +1,94 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"strings"
+)
+
+// operationContextKey : The key of the operation of a request in the context of the request.
+type operationContextKey struct{}
+
+// requestOperation : The operation that built a request, carried in the context of the request for the transport
+// layers.
+type requestOperation struct {
+	id string
+
+	// The values of the path parameters, named after their resource, e.g. "host_id".
+	params map[string]string
+
+	// The kind of resource the operation acts on, e.g. "volume_mapping".
+	resource string
+}
+
+// withOperation returns a context that carries the operation building a request. The path parameters of the path
+// template, such as "/hosts/{id}/volume_mappings", are named after their resource, e.g. "host_id", and the operation
+// acts on the resource of the last literal segment, e.g. "volume_mapping".
+func withOperation(ctx context.Context, operationId string, pathTemplate string, pathParams map[string]string) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	op := requestOperation{id: operationId, params: map[string]string{}}
+	for _, segment := range strings.Split(pathTemplate, "/") {
+		if param, ok := strings.CutPrefix(segment, "{"); ok {
+			param = strings.TrimSuffix(param, "}")
+			name := param
+			if name == "id" {
+				name = op.resource + "_id"
+			}
+			op.params[name] = pathParams[param]
+		} else if segment != "" {
+			op.resource = strings.TrimSuffix(segment, "s")
+		}
+	}
+	return context.WithValue(ctx, operationContextKey{}, op)
+}
+
+// operationOf returns the operation that built a request, or the zero operation if it was not built by an operation
+// of the service.
+func operationOf(req *http.Request) requestOperation {
+	op, _ := req.Context().Value(operationContextKey{}).(requestOperation)
+	return op
+}
+
+// OperationIDs returns the IDs of the operations of the service, sorted.
+func OperationIDs() (ids []string) {
+	api := reflect.TypeOf((*SdsaasV2API)(nil)).Elem()
+	for i := 0; i < api.NumMethod(); i++ {
+		if id, ok := strings.CutSuffix(api.Method(i).Name, "WithContext"); ok {
+			ids = append(ids, id)
+		}
+	}
+	return
+}
+
+// IsIdempotentOperation reports whether repeating an operation has the same effect as sending it once. Only the
+// create operations (POST) are not idempotent; an unknown operation ID is not idempotent either.
+func IsIdempotentOperation(operationId string) bool {
+	if strings.HasPrefix(operationId, "Create") {
+		return false
+	}
+	for _, id := range OperationIDs() {
+		if id == operationId {
+			return true
+		}
+	}
+	return false
+}
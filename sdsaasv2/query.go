@@ -0,0 +1,343 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+	"github.com/go-openapi/strfmt"
+)
+
+// Pager : The pages of the items of a list operation, e.g. a VolumesPager.
+type Pager[T any] interface {
+	HasNext() bool
+	GetNextWithContext(ctx context.Context) (page []T, err error)
+}
+
+// Query : A client-side filter, sort order and limit over the items of a list operation. A Query reads the pages of
+// a Pager and returns the items that match all of its filters. Without a sort order it stops reading pages as soon
+// as it has found Limit items; with one it reads every page, then sorts and limits the items.
+type Query[T any] struct {
+	filters []func(item *T) bool
+	sorts   []querySort[T]
+	limit   int
+}
+
+type querySort[T any] struct {
+	// The JSON path of the field, or "" for a sort function.
+	field      string
+	compare    func(a, b *T) int
+	descending bool
+}
+
+// NewQuery : constructs a Query that matches every item.
+func NewQuery[T any]() *Query[T] {
+	return &Query[T]{}
+}
+
+// Where adds a filter, e.g. VolumeStatusIn("available"). An item must match every filter.
+func (query *Query[T]) Where(filter func(item *T) bool) *Query[T] {
+	query.filters = append(query.filters, filter)
+	return query
+}
+
+// SortBy adds a sort order on a field, named by its JSON path, e.g. "capacity", "created_at" or
+// "source_volume.name". Items without a value sort last. Each sort order breaks the ties of the previous ones.
+func (query *Query[T]) SortBy(field string, descending bool) *Query[T] {
+	query.sorts = append(query.sorts, querySort[T]{field: field, descending: descending})
+	return query
+}
+
+// SortFunc adds a sort order given by a comparison function, which returns a negative number when a sorts before b.
+func (query *Query[T]) SortFunc(compare func(a, b *T) int) *Query[T] {
+	query.sorts = append(query.sorts, querySort[T]{compare: compare})
+	return query
+}
+
+// Limit sets the maximum number of items returned. There is no limit when 0.
+func (query *Query[T]) Limit(limit int) *Query[T] {
+	query.limit = limit
+	return query
+}
+
+// Matches reports whether an item matches every filter of the query.
+func (query *Query[T]) Matches(item *T) bool {
+	for _, filter := range query.filters {
+		if !filter(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// Run reads the pages of the pager and returns the matching items, sorted and limited.
+func (query *Query[T]) Run(ctx context.Context, pager Pager[T]) (items []T, err error) {
+	compare, err := query.comparison()
+	if err != nil {
+		return
+	}
+	for pager.HasNext() {
+		if compare == nil && query.limit > 0 && len(items) >= query.limit {
+			break
+		}
+		var page []T
+		page, err = pager.GetNextWithContext(ctx)
+		if err != nil {
+			err = core.RepurposeSDKProblem(err, "query-page-error")
+			return
+		}
+		for i := range page {
+			if query.Matches(&page[i]) {
+				items = append(items, page[i])
+			}
+		}
+	}
+
+	if compare != nil {
+		slices.SortStableFunc(items, func(a, b T) int { return compare(&a, &b) })
+	}
+	if query.limit > 0 && len(items) > query.limit {
+		items = items[:query.limit]
+	}
+	return
+}
+
+// comparison returns the function comparing items by all the sort orders of the query, or nil without any.
+func (query *Query[T]) comparison() (func(a, b *T) int, error) {
+	if len(query.sorts) == 0 {
+		return nil, nil
+	}
+	compares := make([]func(a, b *T) int, len(query.sorts))
+	for i, sort := range query.sorts {
+		compares[i] = sort.compare
+		if sort.field != "" {
+			index, err := fieldIndex(reflect.TypeFor[T](), sort.field)
+			if err != nil {
+				return nil, err
+			}
+			compares[i] = func(a, b *T) int {
+				return compareFields(fieldByIndex(reflect.ValueOf(a).Elem(), index), fieldByIndex(reflect.ValueOf(b).Elem(), index), sort.descending)
+			}
+		}
+	}
+	return func(a, b *T) int {
+		for _, compare := range compares {
+			if result := compare(a, b); result != 0 {
+				return result
+			}
+		}
+		return 0
+	}, nil
+}
+
+// fieldIndex returns the index of the struct field with a JSON path such as "source_volume.name".
+func fieldIndex(structType reflect.Type, path string) (index []int, err error) {
+	fieldType := structType
+	for _, name := range strings.Split(path, ".") {
+		for fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		found := false
+		if fieldType.Kind() == reflect.Struct {
+			for i := range fieldType.NumField() {
+				field := fieldType.Field(i)
+				if strings.Split(field.Tag.Get("json"), ",")[0] == name {
+					index = append(index, i)
+					fieldType = field.Type
+					found = true
+					break
+				}
+			}
+		}
+		if !found {
+			err = core.SDKErrorf(nil, fmt.Sprintf("%s has no sortable field %q", structType.Name(), path), "unknown-sort-field", common.GetComponentInfo())
+			return
+		}
+	}
+	for fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+	switch fieldType.Kind() {
+	case reflect.String, reflect.Bool, reflect.Int64, reflect.Float64, reflect.Slice:
+	default:
+		if fieldType != reflect.TypeFor[strfmt.DateTime]() {
+			err = core.SDKErrorf(nil, fmt.Sprintf("the field %q of %s cannot be sorted", path, structType.Name()), "unsortable-field", common.GetComponentInfo())
+		}
+	}
+	return
+}
+
+// fieldByIndex returns the value of a field, following pointers, or an invalid value if a pointer on the way is
+// nil.
+func fieldByIndex(value reflect.Value, index []int) reflect.Value {
+	for _, i := range index {
+		for value.Kind() == reflect.Pointer {
+			if value.IsNil() {
+				return reflect.Value{}
+			}
+			value = value.Elem()
+		}
+		value = value.Field(i)
+	}
+	for value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			return reflect.Value{}
+		}
+		value = value.Elem()
+	}
+	return value
+}
+
+// compareFields compares two values of a field; invalid values sort last in either direction. Slices are compared
+// by their length.
+func compareFields(a, b reflect.Value, descending bool) (result int) {
+	switch {
+	case !a.IsValid() && !b.IsValid():
+		return 0
+	case !a.IsValid():
+		return 1
+	case !b.IsValid():
+		return -1
+	}
+	switch a.Kind() {
+	case reflect.String:
+		result = cmp.Compare(a.String(), b.String())
+	case reflect.Bool:
+		result = cmp.Compare(boolToInt(a.Bool()), boolToInt(b.Bool()))
+	case reflect.Int64:
+		result = cmp.Compare(a.Int(), b.Int())
+	case reflect.Float64:
+		result = cmp.Compare(a.Float(), b.Float())
+	case reflect.Slice:
+		result = cmp.Compare(a.Len(), b.Len())
+	default:
+		result = time.Time(a.Interface().(strfmt.DateTime)).Compare(time.Time(b.Interface().(strfmt.DateTime)))
+	}
+	if descending {
+		result = -result
+	}
+	return
+}
+
+func boolToInt(value bool) int {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+// createdBetween reports whether a creation time is within [from, to); a zero bound is open.
+func createdBetween(createdAt *strfmt.DateTime, from time.Time, to time.Time) bool {
+	if createdAt == nil {
+		return false
+	}
+	created := time.Time(*createdAt)
+	return (from.IsZero() || !created.Before(from)) && (to.IsZero() || created.Before(to))
+}
+
+// VolumeStatusIn matches the volumes with one of the statuses.
+func VolumeStatusIn(statuses ...VolumeStatus) func(volume *Volume) bool {
+	return func(volume *Volume) bool {
+		return volume.Status != nil && slices.Contains(statuses, *volume.Status)
+	}
+}
+
+// VolumeCapacityBetween matches the volumes with a capacity, in gigabytes, within [min, max]; max is open when 0.
+func VolumeCapacityBetween(min int64, max int64) func(volume *Volume) bool {
+	return func(volume *Volume) bool {
+		return volume.Capacity != nil && *volume.Capacity >= min && (max == 0 || *volume.Capacity <= max)
+	}
+}
+
+// VolumeCreatedBetween matches the volumes created within [from, to); a zero time is open.
+func VolumeCreatedBetween(from time.Time, to time.Time) func(volume *Volume) bool {
+	return func(volume *Volume) bool {
+		return createdBetween(volume.CreatedAt, from, to)
+	}
+}
+
+// VolumeNameMatches matches the volumes whose name matches the regular expression.
+func VolumeNameMatches(pattern *regexp.Regexp) func(volume *Volume) bool {
+	return func(volume *Volume) bool {
+		return volume.Name != nil && pattern.MatchString(*volume.Name)
+	}
+}
+
+// SnapshotDeletable matches the snapshots that can, or cannot, be deleted.
+func SnapshotDeletable(deletable bool) func(snapshot *Snapshot) bool {
+	return func(snapshot *Snapshot) bool {
+		return snapshot.Deletable != nil && *snapshot.Deletable == deletable
+	}
+}
+
+// SnapshotLifecycleStateIn matches the snapshots in one of the lifecycle states.
+func SnapshotLifecycleStateIn(states ...string) func(snapshot *Snapshot) bool {
+	return func(snapshot *Snapshot) bool {
+		return snapshot.LifecycleState != nil && slices.Contains(states, *snapshot.LifecycleState)
+	}
+}
+
+// SnapshotCreatedBetween matches the snapshots created within [from, to); a zero time is open.
+func SnapshotCreatedBetween(from time.Time, to time.Time) func(snapshot *Snapshot) bool {
+	return func(snapshot *Snapshot) bool {
+		return createdBetween(snapshot.CreatedAt, from, to)
+	}
+}
+
+// SnapshotNameMatches matches the snapshots whose name matches the regular expression.
+func SnapshotNameMatches(pattern *regexp.Regexp) func(snapshot *Snapshot) bool {
+	return func(snapshot *Snapshot) bool {
+		return snapshot.Name != nil && pattern.MatchString(*snapshot.Name)
+	}
+}
+
+// HostPskEnabled matches the hosts with, or without, PSK authentication enabled.
+func HostPskEnabled(enabled bool) func(host *Host) bool {
+	return func(host *Host) bool {
+		return host.PskEnabled != nil && *host.PskEnabled == enabled
+	}
+}
+
+// HostCreatedBetween matches the hosts created within [from, to); a zero time is open.
+func HostCreatedBetween(from time.Time, to time.Time) func(host *Host) bool {
+	return func(host *Host) bool {
+		return createdBetween(host.CreatedAt, from, to)
+	}
+}
+
+// HostNameMatches matches the hosts whose name matches the regular expression.
+func HostNameMatches(pattern *regexp.Regexp) func(host *Host) bool {
+	return func(host *Host) bool {
+		return host.Name != nil && pattern.MatchString(*host.Name)
+	}
+}
+
+// VolumeMappingStatusIn matches the volume mappings with one of the statuses.
+func VolumeMappingStatusIn(statuses ...VolumeMappingStatus) func(volumeMapping *VolumeMapping) bool {
+	return func(volumeMapping *VolumeMapping) bool {
+		return volumeMapping.Status != nil && slices.Contains(statuses, *volumeMapping.Status)
+	}
+}
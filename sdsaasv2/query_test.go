@@ -0,0 +1,162 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Query`, func() {
+	var (
+		testServer    *httptest.Server
+		sdsaasService *sdsaasv2.SdsaasV2
+		pages         []string
+	)
+	ctx := context.Background()
+
+	// Two volumes per page, over three pages.
+	volumes := []string{
+		`{"id": "v1", "name": "db-1", "capacity": 100, "status": "available", "created_at": "2026-01-01T00:00:00Z"}`,
+		`{"id": "v2", "name": "logs-1", "capacity": 10, "status": "available", "created_at": "2026-02-01T00:00:00Z"}`,
+		`{"id": "v3", "name": "db-2", "capacity": 500, "status": "pending", "created_at": "2026-03-01T00:00:00Z"}`,
+		`{"id": "v4", "name": "db-3", "capacity": 200, "status": "available", "created_at": "2026-04-01T00:00:00Z"}`,
+		`{"id": "v5", "name": "web-1", "capacity": 200, "status": "available", "created_at": "2026-05-01T00:00:00Z"}`,
+		`{"id": "v6", "name": "db-4", "status": "available"}`,
+	}
+	snapshots := []string{
+		`{"id": "s1", "name": "nightly", "deletable": true, "source_volume": {"id": "v2", "name": "logs-1"}}`,
+		`{"id": "s2", "name": "manual", "deletable": false, "source_volume": {"id": "v1", "name": "db-1"}}`,
+		`{"id": "s3", "name": "nightly", "deletable": true}`,
+		`{"id": "s4", "name": "nightly", "deletable": true, "source_volume": {"id": "v1", "name": "db-1"}}`,
+	}
+
+	BeforeEach(func() {
+		pages = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			start := 0
+			fmt.Sscanf(req.URL.Query().Get("start"), "%d", &start)
+			pages = append(pages, fmt.Sprintf("%s@%d", req.URL.Path, start))
+
+			items, property := volumes, "volumes"
+			if req.URL.Path == "/snapshots" {
+				items, property = snapshots, "snapshots"
+			}
+			next := ""
+			if start+2 < len(items) {
+				next = fmt.Sprintf(`, "next": {"href": "%s%s?start=%d"}`, testServer.URL, req.URL.Path, start+2)
+			}
+			res.Header().Set("Content-type", "application/json")
+			res.WriteHeader(200)
+			fmt.Fprintf(res, `{"%s": [%s]%s}`, property, strings.Join(items[start:start+2], ","), next)
+		}))
+		var err error
+		sdsaasService, err = sdsaasv2.NewSdsaasV2(&sdsaasv2.SdsaasV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	volumeIDs := func(items []sdsaasv2.Volume) (ids []string) {
+		for _, item := range items {
+			ids = append(ids, *item.ID)
+		}
+		return
+	}
+	newVolumesPager := func() *sdsaasv2.VolumesPager {
+		pager, err := sdsaasService.NewVolumesPager(sdsaasService.NewListVolumesOptions())
+		Expect(err).To(BeNil())
+		return pager
+	}
+
+	It(`Filters, sorts and limits volumes`, func() {
+		items, err := sdsaasv2.NewQuery[sdsaasv2.Volume]().
+			Where(sdsaasv2.VolumeStatusIn("available")).
+			Where(sdsaasv2.VolumeCapacityBetween(50, 0)).
+			SortBy("capacity", true).
+			SortBy("name", false).
+			Run(ctx, newVolumesPager())
+		Expect(err).To(BeNil())
+		Expect(volumeIDs(items)).To(Equal([]string{"v4", "v5", "v1"}))
+
+		items, err = sdsaasv2.NewQuery[sdsaasv2.Volume]().
+			Where(sdsaasv2.VolumeNameMatches(regexp.MustCompile(`^db-`))).
+			Where(sdsaasv2.VolumeCreatedBetween(time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), time.Time{})).
+			SortBy("created_at", true).
+			Limit(1).
+			Run(ctx, newVolumesPager())
+		Expect(err).To(BeNil())
+		Expect(volumeIDs(items)).To(Equal([]string{"v4"}))
+		Expect(pages).To(HaveLen(6))
+	})
+	It(`Stops reading pages once the limit is met`, func() {
+		items, err := sdsaasv2.NewQuery[sdsaasv2.Volume]().
+			Where(sdsaasv2.VolumeNameMatches(regexp.MustCompile(`^db-`))).
+			Limit(2).
+			Run(ctx, newVolumesPager())
+		Expect(err).To(BeNil())
+		Expect(volumeIDs(items)).To(Equal([]string{"v1", "v3"}))
+		Expect(pages).To(Equal([]string{"/volumes@0", "/volumes@2"}))
+	})
+	It(`Sorts by nested fields with missing values last`, func() {
+		pager, err := sdsaasService.NewSnapshotsPager(sdsaasService.NewListSnapshotsOptions())
+		Expect(err).To(BeNil())
+		items, err := sdsaasv2.NewQuery[sdsaasv2.Snapshot]().
+			Where(sdsaasv2.SnapshotDeletable(true)).
+			SortBy("source_volume.name", false).
+			SortFunc(func(a, b *sdsaasv2.Snapshot) int { return strings.Compare(*b.ID, *a.ID) }).
+			Run(ctx, pager)
+		Expect(err).To(BeNil())
+		Expect(items).To(HaveLen(3))
+		Expect(*items[0].ID).To(Equal("s4"))
+		Expect(*items[1].ID).To(Equal("s1"))
+		Expect(*items[2].ID).To(Equal("s3"))
+	})
+	It(`Rejects unknown sort fields`, func() {
+		_, err := sdsaasv2.NewQuery[sdsaasv2.Volume]().SortBy("colour", false).Run(ctx, newVolumesPager())
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring(`no sortable field "colour"`))
+		_, err = sdsaasv2.NewQuery[sdsaasv2.Volume]().SortBy("source_snapshot", false).Run(ctx, newVolumesPager())
+		Expect(err).ToNot(BeNil())
+		Expect(pages).To(BeEmpty())
+	})
+	It(`Filters hosts and volume mappings`, func() {
+		enabled, disabled := true, false
+		hosts := []sdsaasv2.Host{{Name: core.StringPtr("a"), PskEnabled: &enabled}, {Name: core.StringPtr("b"), PskEnabled: &disabled}}
+		query := sdsaasv2.NewQuery[sdsaasv2.Host]().Where(sdsaasv2.HostPskEnabled(true))
+		Expect(query.Matches(&hosts[0])).To(BeTrue())
+		Expect(query.Matches(&hosts[1])).To(BeFalse())
+
+		status := sdsaasv2.VolumeMappingStatusMappedConst
+		mapping := sdsaasv2.VolumeMapping{Status: &status}
+		Expect(sdsaasv2.NewQuery[sdsaasv2.VolumeMapping]().Where(sdsaasv2.VolumeMappingStatusIn("mapped", "pending")).Matches(&mapping)).To(BeTrue())
+	})
+})
@@ -0,0 +1,167 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+)
+
+// Constants associated with the response validation modes of the service.
+const (
+	// ResponseValidationModeOffConst : Responses are not validated.
+	ResponseValidationModeOffConst = "off"
+
+	// ResponseValidationModeLenientConst : The problems of invalid responses are logged as warnings.
+	ResponseValidationModeLenientConst = "lenient"
+
+	// ResponseValidationModeStrictConst : Operations that receive an invalid response fail with a
+	// ResponseValidationError.
+	ResponseValidationModeStrictConst = "strict"
+)
+
+// ErrInvalidResponse is matched by the errors of the operations that receive an invalid response in strict mode.
+var ErrInvalidResponse = errors.New("the response does not match the API definition")
+
+// ResponseValidationError : The error of an operation that received an invalid response in strict mode.
+type ResponseValidationError struct {
+	// The operation ID, e.g. "GetVolume".
+	OperationID string
+
+	// The status code of the response.
+	StatusCode int
+
+	// The problems of the response, e.g. `volume_mappings[0].gateways is missing`.
+	Problems []string
+}
+
+// Error lists the problems of the response.
+func (validationError *ResponseValidationError) Error() string {
+	return fmt.Sprintf("the response of %s is not valid: %s", validationError.OperationID, strings.Join(validationError.Problems, "; "))
+}
+
+// Is reports whether the target is ErrInvalidResponse.
+func (validationError *ResponseValidationError) Is(target error) bool {
+	return target == ErrInvalidResponse
+}
+
+// ValidateModel returns the problems of a model received from the service: the properties whose validate tag is
+// "required" and that are missing, in the model and in the models it holds. The problems name the properties by
+// their JSON path, e.g. `volume_mappings[0].gateways is missing`.
+func ValidateModel(model interface{}) (problems []string) {
+	validateValue(reflect.ValueOf(model), "", &problems)
+	return
+}
+
+func validateValue(value reflect.Value, path string, problems *[]string) {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !value.IsNil() {
+			validateValue(value.Elem(), path, problems)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			validateValue(value.Index(i), fmt.Sprintf("%s[%d]", path, i), problems)
+		}
+	case reflect.Struct:
+		valueType := value.Type()
+		if valueType.PkgPath() != reflect.TypeOf(Volume{}).PkgPath() {
+			return
+		}
+		for i := 0; i < valueType.NumField(); i++ {
+			field := valueType.Field(i)
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if !field.IsExported() || name == "" || name == "-" {
+				continue
+			}
+			if path != "" {
+				name = path + "." + name
+			}
+			fieldValue := value.Field(i)
+			if isRequired(field) && isMissing(fieldValue) {
+				*problems = append(*problems, name+" is missing")
+				continue
+			}
+			validateValue(fieldValue, name, problems)
+		}
+	}
+}
+
+func isRequired(field reflect.StructField) bool {
+	for _, rule := range strings.Split(field.Tag.Get("validate"), ",") {
+		if rule == "required" {
+			return true
+		}
+	}
+	return false
+}
+
+func isMissing(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Slice, reflect.Map:
+		return value.IsNil()
+	}
+	return false
+}
+
+// validateResponse validates the result of an operation according to the response validation mode of the service.
+// In strict mode, the error of an invalid result holds a ResponseValidationError. The synthetic results of dry-run
+// mode are not validated, since they only echo the request.
+func (sdsaas *SdsaasV2) validateResponse(operationId string, response *core.DetailedResponse, result interface{}) error {
+	if sdsaas.responseValidation == "" || IsDryRunResponse(response) {
+		return nil
+	}
+	problems := ValidateModel(result)
+	if len(problems) == 0 {
+		return nil
+	}
+	validationError := &ResponseValidationError{OperationID: operationId, StatusCode: response.StatusCode, Problems: problems}
+	if sdsaas.responseValidation != ResponseValidationModeStrictConst {
+		core.GetLogger().Warn("%s\n", validationError.Error())
+		return nil
+	}
+	return core.SDKErrorf(validationError, "", "invalid-response", common.GetComponentInfo())
+}
+
+// SetResponseValidation sets how the results of the operations are checked against the models of the API (see
+// ValidateModel): not at all, the default; leniently, logging the problems as warnings; or strictly, failing the
+// operation with an error that matches ErrInvalidResponse and holds a ResponseValidationError, in place of returning
+// a result with missing properties. The DetailedResponse is still returned, with the result in its Result.
+func (sdsaas *SdsaasV2) SetResponseValidation(mode string) error {
+	switch mode {
+	case ResponseValidationModeOffConst:
+		sdsaas.responseValidation = ""
+	case ResponseValidationModeLenientConst, ResponseValidationModeStrictConst:
+		sdsaas.responseValidation = mode
+	default:
+		return core.SDKErrorf(nil, fmt.Sprintf("unknown response validation mode %q", mode), "unknown-validation-mode", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// GetResponseValidation returns the response validation mode of the service.
+func (sdsaas *SdsaasV2) GetResponseValidation() string {
+	if sdsaas.responseValidation == "" {
+		return ResponseValidationModeOffConst
+	}
+	return sdsaas.responseValidation
+}
@@ -0,0 +1,115 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Response validation`, func() {
+	const validVolume = `{"id": "v1", "href": "https://sds/volumes/v1", "name": "data", "created_at": "2026-01-02T03:04:05.000Z",
+		"resource_type": "volume", "capacity": 10, "bandwidth": 100, "iops": 1000, "status": "available",
+		"status_reasons": [], "source_snapshot": {"id": "s1"}, "volume_mappings": [%s]}`
+	var (
+		testServer    *httptest.Server
+		sdsaasService *sdsaasv2.SdsaasV2
+	)
+	BeforeEach(func() {
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.Path {
+			case "/volumes/v1":
+				fmt.Fprintf(res, validVolume, `{"id": "m1", "href": "https://sds/m1", "status": "mapped",
+					"volume": {"id": "v1", "name": "data"}, "host": {"id": "h1", "name": "node"}}`)
+			case "/volumes/v2":
+				fmt.Fprintf(res, validVolume, "")
+			default:
+				res.WriteHeader(404)
+				fmt.Fprint(res, `{"errors": [{"code": "not_found"}]}`)
+			}
+		}))
+		var err error
+		sdsaasService, err = sdsaasv2.NewSdsaasV2(&sdsaasv2.SdsaasV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	It(`Fails operations that receive invalid responses in strict mode`, func() {
+		Expect(sdsaasService.GetResponseValidation()).To(Equal(sdsaasv2.ResponseValidationModeOffConst))
+		volume, _, err := sdsaasService.GetVolume(sdsaasService.NewGetVolumeOptions("v1"))
+		Expect(err).To(BeNil())
+		Expect(volume.VolumeMappings[0].Gateways).To(BeNil())
+
+		Expect(sdsaasService.SetResponseValidation(sdsaasv2.ResponseValidationModeStrictConst)).To(Succeed())
+		Expect(sdsaasService.GetResponseValidation()).To(Equal(sdsaasv2.ResponseValidationModeStrictConst))
+		volume, response, err := sdsaasService.GetVolume(sdsaasService.NewGetVolumeOptions("v1"))
+		Expect(volume).To(BeNil())
+		Expect(errors.Is(err, sdsaasv2.ErrInvalidResponse)).To(BeTrue())
+		// The response is returned with the error.
+		Expect(response.StatusCode).To(Equal(200))
+		Expect(*response.Result.(*sdsaasv2.Volume).ID).To(Equal("v1"))
+		var validationError *sdsaasv2.ResponseValidationError
+		Expect(errors.As(err, &validationError)).To(BeTrue())
+		Expect(validationError.OperationID).To(Equal("GetVolume"))
+		Expect(validationError.Problems).To(Equal([]string{
+			"volume_mappings[0].host.nqn is missing",
+			"volume_mappings[0].gateways is missing",
+		}))
+
+		volume, _, err = sdsaasService.GetVolume(sdsaasService.NewGetVolumeOptions("v2"))
+		Expect(err).To(BeNil())
+		Expect(*volume.Name).To(Equal("data"))
+		_, response, err = sdsaasService.GetVolume(sdsaasService.NewGetVolumeOptions("v3"))
+		Expect(errors.Is(err, sdsaasv2.ErrInvalidResponse)).To(BeFalse())
+		Expect(response.StatusCode).To(Equal(404))
+	})
+	It(`Only logs the problems in lenient mode`, func() {
+		Expect(sdsaasService.SetResponseValidation(sdsaasv2.ResponseValidationModeLenientConst)).To(Succeed())
+		volume, _, err := sdsaasService.GetVolume(sdsaasService.NewGetVolumeOptions("v1"))
+		Expect(err).To(BeNil())
+		Expect(*volume.ID).To(Equal("v1"))
+
+		Expect(sdsaasService.SetResponseValidation("pedantic")).ToNot(Succeed())
+		Expect(sdsaasService.SetResponseValidation(sdsaasv2.ResponseValidationModeOffConst)).To(Succeed())
+		Expect(sdsaasService.GetResponseValidation()).To(Equal(sdsaasv2.ResponseValidationModeOffConst))
+	})
+	It(`Does not validate the results of dry-run mode`, func() {
+		Expect(sdsaasService.SetResponseValidation(sdsaasv2.ResponseValidationModeStrictConst)).To(Succeed())
+		sdsaasService.SetDryRun(sdsaasv2.NewRecordingDryRunSink())
+		volume, response, err := sdsaasService.CreateVolume(sdsaasService.NewCreateVolumeOptions(10).SetName("data"))
+		Expect(err).To(BeNil())
+		Expect(sdsaasv2.IsDryRunResponse(response)).To(BeTrue())
+		Expect(*volume.Name).To(Equal("data"))
+	})
+	It(`Validates models`, func() {
+		Expect(sdsaasv2.ValidateModel(&sdsaasv2.VolumeMapping{})).To(ContainElements("status is missing", "gateways is missing"))
+		Expect(sdsaasv2.ValidateModel(&sdsaasv2.Gateway{IPAddress: core.StringPtr("10.0.0.1"), Port: core.Int64Ptr(4420)})).To(BeEmpty())
+	})
+})
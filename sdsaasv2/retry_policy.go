@@ -0,0 +1,305 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// HeaderNameAttemptCount is the header, added by the client to the responses of the requests sent through a
+// RetryPolicy, that holds the number of attempts made. It is not sent by the service; see GetAttemptCount.
+const HeaderNameAttemptCount = "X-Sds-Attempt-Count"
+
+// Defaults of a RetryPolicy.
+const (
+	DefaultRetryPolicyMaxRetries  = 4
+	DefaultRetryPolicyMinInterval = 1 * time.Second
+	DefaultRetryPolicyMaxInterval = 30 * time.Second
+
+	// The largest error response body inspected for an error code.
+	maxRetryErrorBodySize = 1 << 20
+)
+
+// RetryPolicy : Decides which failed requests are retried and how long to wait between attempts.
+//
+// Idempotent operations (see IsIdempotentOperation) are retried after a network error, a retryable status code or
+// a retryable error code. Create operations are only retried after a 429 response, since the request was rejected
+// before it was processed, unless an OperationRetryPolicy says otherwise.
+type RetryPolicy struct {
+	// The maximum number of retries after the first attempt.
+	MaxRetries int
+
+	// The wait before the first retry, doubled before each further retry.
+	MinRetryInterval time.Duration
+
+	// The longest wait between attempts, including one asked for by a Retry-After header.
+	MaxRetryInterval time.Duration
+
+	// The status codes of responses that are retried.
+	RetryableStatusCodes []int
+
+	// The ErrorObject codes of error responses that are retried, whatever their status code.
+	RetryableErrorCodes []string
+
+	// Overrides of the policy by operation ID (e.g. "CreateVolume").
+	Operations map[string]*OperationRetryPolicy
+}
+
+// OperationRetryPolicy : Overrides a RetryPolicy for one operation.
+type OperationRetryPolicy struct {
+	// Whether failed requests of the operation are retried. By default only idempotent operations are.
+	Retry *bool
+
+	// The maximum number of retries of the operation. RetryPolicy.MaxRetries is used when 0.
+	MaxRetries int
+}
+
+// NewRetryPolicy : constructs a RetryPolicy with the default settings.
+func NewRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries:       DefaultRetryPolicyMaxRetries,
+		MinRetryInterval: DefaultRetryPolicyMinInterval,
+		MaxRetryInterval: DefaultRetryPolicyMaxInterval,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryableErrorCodes: []string{
+			ErrorObjectCodeConfigUnavailableConst,
+			ErrorObjectCodeEndpointUnavailableConst,
+		},
+	}
+}
+
+// SetOperation sets the override of an operation.
+func (policy *RetryPolicy) SetOperation(operationId string, operationPolicy *OperationRetryPolicy) *RetryPolicy {
+	if policy.Operations == nil {
+		policy.Operations = map[string]*OperationRetryPolicy{}
+	}
+	policy.Operations[operationId] = operationPolicy
+	return policy
+}
+
+// maxRetries returns the maximum number of retries of an operation, and whether it is retried beyond 429
+// responses.
+func (policy *RetryPolicy) maxRetries(operationId string) (maxRetries int, retryAll bool) {
+	maxRetries = policy.MaxRetries
+	retryAll = IsIdempotentOperation(operationId)
+	if operationPolicy := policy.Operations[operationId]; operationPolicy != nil {
+		if operationPolicy.MaxRetries > 0 {
+			maxRetries = operationPolicy.MaxRetries
+		}
+		if operationPolicy.Retry != nil {
+			retryAll = *operationPolicy.Retry
+			if !retryAll {
+				maxRetries = 0
+			}
+		}
+	}
+	return
+}
+
+// retryable reports whether a response or error is retried.
+func (policy *RetryPolicy) retryable(res *http.Response, err error, retryAll bool) bool {
+	if err != nil {
+		return retryAll && !errors.Is(err, ErrCircuitOpen)
+	}
+	if res.StatusCode == http.StatusTooManyRequests {
+		return slices.Contains(policy.RetryableStatusCodes, res.StatusCode)
+	}
+	if !retryAll || res.StatusCode < 400 {
+		return false
+	}
+	if slices.Contains(policy.RetryableStatusCodes, res.StatusCode) {
+		return true
+	}
+	return len(policy.RetryableErrorCodes) > 0 && slices.ContainsFunc(errorCodes(res), func(code string) bool {
+		return slices.Contains(policy.RetryableErrorCodes, code)
+	})
+}
+
+// wait returns how long to wait before a retry: the Retry-After of a 429 or 503 response, else an exponential
+// backoff, at most MaxRetryInterval.
+func (policy *RetryPolicy) wait(attempt int, res *http.Response) time.Duration {
+	wait := policy.MinRetryInterval
+	for i := 1; i < attempt; i++ {
+		if wait > math.MaxInt64/2 {
+			wait = math.MaxInt64
+			break
+		}
+		wait *= 2
+	}
+	if res != nil && (res.StatusCode == http.StatusTooManyRequests || res.StatusCode == http.StatusServiceUnavailable) {
+		if retryAfter, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			wait = retryAfter
+		}
+	}
+	if policy.MaxRetryInterval > 0 && wait > policy.MaxRetryInterval {
+		wait = policy.MaxRetryInterval
+	}
+	return wait
+}
+
+// parseRetryAfter parses a Retry-After header holding a number of seconds or an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+// errorCodes returns the ErrorObject codes of an error response. The body is read and replaced, so that it can
+// still be read by the caller.
+func errorCodes(res *http.Response) (codes []string) {
+	if res.Body == nil {
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxRetryErrorBodySize))
+	res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return
+	}
+	var errorResponse struct {
+		Errors []ErrorObject `json:"errors"`
+	}
+	if json.Unmarshal(body, &errorResponse) != nil {
+		return
+	}
+	for _, errorObject := range errorResponse.Errors {
+		if errorObject.Code != nil {
+			codes = append(codes, *errorObject.Code)
+		}
+	}
+	return
+}
+
+// retryTransport : Retries the requests of the service according to a RetryPolicy.
+type retryTransport struct {
+	policy *RetryPolicy
+	next   http.RoundTripper
+}
+
+// RoundTrip sends the request until it succeeds, is not retryable or runs out of retries.
+func (transport *retryTransport) RoundTrip(req *http.Request) (res *http.Response, err error) {
+	maxRetries, retryAll := transport.policy.maxRetries(operationOf(req).id)
+	// A request whose body cannot be read again is sent once.
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		maxRetries = 0
+	}
+
+	attemptReq := req
+	for attempt := 1; ; attempt++ {
+		res, err = transport.next.RoundTrip(attemptReq)
+		if attempt > maxRetries || req.Context().Err() != nil || !transport.policy.retryable(res, err, retryAll) {
+			if res != nil {
+				res.Header.Set(HeaderNameAttemptCount, strconv.Itoa(attempt))
+			}
+			return
+		}
+
+		wait := transport.policy.wait(attempt, res)
+		if res != nil {
+			io.Copy(io.Discard, io.LimitReader(res.Body, maxRetryErrorBodySize))
+			res.Body.Close()
+		}
+		core.GetLogger().Debug("Retrying request %s %s in %s (attempt %d)\n", req.Method, req.URL.Redacted(), wait, attempt+1)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		attemptReq = req.Clone(req.Context())
+		if req.GetBody != nil {
+			attemptReq.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+func (transport *retryTransport) position() int {
+	return transportLayerRetry
+}
+
+func (transport *retryTransport) unwrap() http.RoundTripper {
+	return transport.next
+}
+
+func (transport *retryTransport) withNext(next http.RoundTripper) transportLayer {
+	return &retryTransport{policy: transport.policy, next: next}
+}
+
+// SetRetryPolicy replaces the automatic retries of the service with a RetryPolicy, or disables them when the
+// policy is nil. It disables the retries enabled by EnableRetries, which would apply to every operation alike.
+//
+// The policy wraps the transport of the HTTP client of the service, so SSL verification must be configured
+// before it is set.
+func (sdsaas *SdsaasV2) SetRetryPolicy(policy *RetryPolicy) {
+	sdsaas.Service.DisableRetries()
+	if policy == nil {
+		sdsaas.setTransportLayer(transportLayerRetry, nil)
+	} else {
+		sdsaas.setTransportLayer(transportLayerRetry, &retryTransport{policy: policy})
+	}
+}
+
+// GetRetryPolicy returns the RetryPolicy of the service, or nil if none is set.
+func (sdsaas *SdsaasV2) GetRetryPolicy() *RetryPolicy {
+	if transport, ok := sdsaas.getTransportLayer(transportLayerRetry).(*retryTransport); ok {
+		return transport.policy
+	}
+	return nil
+}
+
+// GetAttemptCount returns the number of attempts made for the request of a response, e.g. after
+//
+//	_, response, err := sdsaasService.ListVolumes(listVolumesOptions)
+//
+// It is 1 unless a RetryPolicy retried the request, and 0 without a response.
+func GetAttemptCount(response *core.DetailedResponse) int {
+	if response == nil {
+		return 0
+	}
+	if attempts, err := strconv.Atoi(response.GetHeaders().Get(HeaderNameAttemptCount)); err == nil {
+		return attempts
+	}
+	return 1
+}
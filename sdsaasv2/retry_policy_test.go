@@ -0,0 +1,176 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`RetryPolicy`, func() {
+	var (
+		testServer    *httptest.Server
+		sdsaasService *sdsaasv2.SdsaasV2
+		failures      []string
+		requests      []string
+	)
+	BeforeEach(func() {
+		requests = nil
+		failures = nil
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			defer GinkgoRecover()
+
+			body, _ := io.ReadAll(req.Body)
+			requests = append(requests, req.Method+" "+req.URL.Path+" "+string(body))
+			res.Header().Set("Content-type", "application/json")
+			if len(failures) > 0 {
+				var status int
+				var code string
+				fmt.Sscanf(failures[0], "%d %s", &status, &code)
+				failures = failures[1:]
+				res.Header().Set("Retry-After", "0")
+				res.WriteHeader(status)
+				fmt.Fprintf(res, `{"errors": [{"code": "%s", "message": "failed"}]}`, code)
+				return
+			}
+			res.WriteHeader(200)
+			fmt.Fprint(res, `{"id": "r1", "volumes": []}`)
+		}))
+		var err error
+		sdsaasService, err = sdsaasv2.NewSdsaasV2(&sdsaasv2.SdsaasV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		policy := sdsaasv2.NewRetryPolicy()
+		policy.MinRetryInterval = time.Millisecond
+		policy.MaxRetryInterval = 10 * time.Millisecond
+		sdsaasService.SetRetryPolicy(policy)
+	})
+	AfterEach(func() {
+		testServer.Close()
+	})
+
+	createVolume := func() (*core.DetailedResponse, error) {
+		_, response, err := sdsaasService.CreateVolume(sdsaasService.NewCreateVolumeOptions(10).SetName("data"))
+		return response, err
+	}
+
+	It(`Classifies operations`, func() {
+		Expect(sdsaasv2.IsIdempotentOperation("ListVolumes")).To(BeTrue())
+		Expect(sdsaasv2.IsIdempotentOperation("UpdateVolume")).To(BeTrue())
+		Expect(sdsaasv2.IsIdempotentOperation("ReplaceSslCert")).To(BeTrue())
+		Expect(sdsaasv2.IsIdempotentOperation("CreateVolume")).To(BeFalse())
+		Expect(sdsaasv2.IsIdempotentOperation("CreateHmacCredentials")).To(BeFalse())
+		Expect(sdsaasv2.IsIdempotentOperation("Unknown")).To(BeFalse())
+		Expect(sdsaasv2.OperationIDs()).To(HaveLen(29))
+	})
+	It(`Retries idempotent operations and counts the attempts`, func() {
+		failures = []string{"503 endpoint_unavailable", "502 internal_error"}
+		_, response, err := sdsaasService.ListVolumes(sdsaasService.NewListVolumesOptions())
+		Expect(err).To(BeNil())
+		Expect(requests).To(HaveLen(3))
+		Expect(sdsaasv2.GetAttemptCount(response)).To(Equal(3))
+
+		failures = []string{"500 config_unavailable"}
+		_, response, err = sdsaasService.GetVolume(sdsaasService.NewGetVolumeOptions("r1"))
+		Expect(err).To(BeNil())
+		Expect(sdsaasv2.GetAttemptCount(response)).To(Equal(2))
+
+		failures = []string{"500 internal_error"}
+		_, response, err = sdsaasService.GetVolume(sdsaasService.NewGetVolumeOptions("r1"))
+		Expect(err).ToNot(BeNil())
+		Expect(response.StatusCode).To(Equal(500))
+		Expect(sdsaasv2.GetAttemptCount(response)).To(Equal(1))
+	})
+	It(`Gives up after the maximum number of retries`, func() {
+		failures = []string{"503 a", "503 b", "503 c", "503 d", "503 e", "503 f"}
+		_, response, err := sdsaasService.ListVolumes(sdsaasService.NewListVolumesOptions())
+		Expect(err).ToNot(BeNil())
+		Expect(err.Error()).To(ContainSubstring("failed"))
+		Expect(sdsaasv2.GetAttemptCount(response)).To(Equal(5))
+		Expect(failures).To(Equal([]string{"503 f"}))
+	})
+	It(`Only retries create operations after a 429`, func() {
+		failures = []string{"503 endpoint_unavailable"}
+		response, err := createVolume()
+		Expect(err).ToNot(BeNil())
+		Expect(sdsaasv2.GetAttemptCount(response)).To(Equal(1))
+
+		requests = nil
+		failures = []string{"429 too_many_requests"}
+		response, err = createVolume()
+		Expect(err).To(BeNil())
+		Expect(sdsaasv2.GetAttemptCount(response)).To(Equal(2))
+		// The body is sent again.
+		Expect(requests).To(HaveLen(2))
+		Expect(requests[1]).To(Equal(requests[0]))
+		Expect(requests[1]).To(ContainSubstring(`"name":"data"`))
+	})
+	It(`Applies per-operation overrides`, func() {
+		retry, noRetry := true, false
+		policy := sdsaasService.GetRetryPolicy()
+		policy.SetOperation("CreateVolume", &sdsaasv2.OperationRetryPolicy{Retry: &retry, MaxRetries: 1})
+		policy.SetOperation("ListVolumes", &sdsaasv2.OperationRetryPolicy{Retry: &noRetry})
+
+		failures = []string{"503 endpoint_unavailable", "503 endpoint_unavailable"}
+		response, err := createVolume()
+		Expect(err).ToNot(BeNil())
+		Expect(sdsaasv2.GetAttemptCount(response)).To(Equal(2))
+
+		failures = []string{"429 too_many_requests"}
+		_, response, err = sdsaasService.ListVolumes(sdsaasService.NewListVolumesOptions())
+		Expect(err).ToNot(BeNil())
+		Expect(sdsaasv2.GetAttemptCount(response)).To(Equal(1))
+	})
+	It(`Caps the Retry-After wait`, func() {
+		testServer.Config.Handler = http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			res.Header().Set("Retry-After", "3600")
+			res.WriteHeader(429)
+		})
+		started := time.Now()
+		_, response, err := sdsaasService.ListVolumes(sdsaasService.NewListVolumesOptions())
+		Expect(err).ToNot(BeNil())
+		Expect(sdsaasv2.GetAttemptCount(response)).To(Equal(5))
+		Expect(time.Since(started)).To(BeNumerically("<", time.Second))
+	})
+	It(`Is removed by EnableRetries and when nil`, func() {
+		sdsaasService.EnableRetries(1, time.Millisecond)
+		Expect(sdsaasService.GetRetryPolicy()).To(BeNil())
+		sdsaasService.DisableRetries()
+
+		sdsaasService.SetRetryPolicy(sdsaasv2.NewRetryPolicy())
+		clone := sdsaasService.Clone()
+		clone.SetRetryPolicy(nil)
+		Expect(clone.GetRetryPolicy()).To(BeNil())
+		Expect(sdsaasService.GetRetryPolicy()).ToNot(BeNil())
+
+		_, response, err := clone.ListVolumes(clone.NewListVolumesOptions())
+		Expect(err).To(BeNil())
+		Expect(response.GetHeaders().Get(sdsaasv2.HeaderNameAttemptCount)).To(BeEmpty())
+		Expect(sdsaasv2.GetAttemptCount(response)).To(Equal(1))
+		Expect(sdsaasv2.GetAttemptCount(nil)).To(BeZero())
+	})
+})
@@ -0,0 +1,373 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"bytes"
+	"errors"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+)
+
+// DefaultS3ClientProfile is the profile, remote or section name used when S3ClientConfig.Profile is not set.
+const DefaultS3ClientProfile = "default"
+
+// S3ClientConfig : HMAC credentials and the S3 endpoint they are used with, rendered into the configuration files of
+// common S3 tools. Every writer replaces the file atomically with owner-only (0600) permissions and only changes the
+// selected profile; other profiles and unrelated settings are preserved.
+type S3ClientConfig struct {
+	// The access key of the HMAC credential.
+	AccessKey *string `validate:"required,ne="`
+
+	// The secret key of the HMAC credential.
+	SecretKey *string `validate:"required,ne="`
+
+	// The URL of the S3 endpoint, e.g. https://s3.example.com.
+	Endpoint *string `validate:"required,ne="`
+
+	// The region to configure. Omitted when not set.
+	Region *string
+
+	// The profile, remote or section name to write. Defaults to DefaultS3ClientProfile.
+	Profile *string
+}
+
+// NewS3ClientConfig : Instantiate S3ClientConfig from the result of CreateHmacCredentials.
+func NewS3ClientConfig(credentials *AccessKeyResponse, endpoint string) (config *S3ClientConfig, err error) {
+	err = core.ValidateNotNil(credentials, "credentials cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	config = &S3ClientConfig{
+		AccessKey: credentials.AccessKey,
+		SecretKey: credentials.SecretKey,
+		Endpoint:  core.StringPtr(endpoint),
+	}
+	err = config.validate()
+	if err != nil {
+		config = nil
+	}
+	return
+}
+
+// SetRegion : Allow user to set Region
+func (config *S3ClientConfig) SetRegion(region string) *S3ClientConfig {
+	config.Region = core.StringPtr(region)
+	return config
+}
+
+// SetProfile : Allow user to set Profile
+func (config *S3ClientConfig) SetProfile(profile string) *S3ClientConfig {
+	config.Profile = core.StringPtr(profile)
+	return config
+}
+
+// WriteAwsProfile writes the credentials to an AWS shared credentials file (e.g. ~/.aws/credentials) and the endpoint
+// and region to an AWS config file (e.g. ~/.aws/config). Either path may be empty to skip that file.
+func (config *S3ClientConfig) WriteAwsProfile(credentialsPath string, configPath string) (err error) {
+	err = config.validate()
+	if err != nil {
+		return
+	}
+	profile := config.profile()
+
+	if credentialsPath != "" {
+		err = mergeIniFile(credentialsPath, profile, []iniValue{
+			{"aws_access_key_id", *config.AccessKey},
+			{"aws_secret_access_key", *config.SecretKey},
+		})
+		if err != nil {
+			return
+		}
+	}
+
+	if configPath != "" {
+		// The config file prefixes every section except the default one with "profile".
+		section := profile
+		if section != DefaultS3ClientProfile {
+			section = "profile " + section
+		}
+		values := []iniValue{{"endpoint_url", *config.Endpoint}}
+		if config.Region != nil {
+			values = append(values, iniValue{"region", *config.Region})
+		}
+		err = mergeIniFile(configPath, section, values)
+	}
+	return
+}
+
+// WriteRcloneRemote writes an S3 remote, named after the profile, to an rclone configuration file
+// (e.g. ~/.config/rclone/rclone.conf).
+func (config *S3ClientConfig) WriteRcloneRemote(path string) (err error) {
+	err = config.validate()
+	if err != nil {
+		return
+	}
+	values := []iniValue{
+		{"type", "s3"},
+		{"provider", "Ceph"},
+		{"env_auth", "false"},
+		{"access_key_id", *config.AccessKey},
+		{"secret_access_key", *config.SecretKey},
+		{"endpoint", *config.Endpoint},
+	}
+	if config.Region != nil {
+		values = append(values, iniValue{"region", *config.Region})
+	}
+	err = mergeIniFile(path, config.profile(), values)
+	return
+}
+
+// WriteS3cmdConfig writes the profile's section of an s3cmd configuration file (e.g. ~/.s3cfg).
+func (config *S3ClientConfig) WriteS3cmdConfig(path string) (err error) {
+	err = config.validate()
+	if err != nil {
+		return
+	}
+	endpoint, err := url.Parse(*config.Endpoint)
+	if err != nil || endpoint.Host == "" {
+		err = core.SDKErrorf(err, "the S3 endpoint must be an absolute URL", "invalid-endpoint", common.GetComponentInfo())
+		return
+	}
+	useHTTPS := "True"
+	if endpoint.Scheme == "http" {
+		useHTTPS = "False"
+	}
+	values := []iniValue{
+		{"access_key", *config.AccessKey},
+		{"secret_key", *config.SecretKey},
+		{"host_base", endpoint.Host},
+		{"host_bucket", endpoint.Host},
+		{"use_https", useHTTPS},
+	}
+	if config.Region != nil {
+		values = append(values, iniValue{"bucket_location", *config.Region})
+	}
+	err = mergeIniFile(path, config.profile(), values)
+	return
+}
+
+// WriteEnvFile writes AWS_* variables to an environment file that can be sourced by a shell or passed to tools that
+// read dotenv files. Other variables in the file are preserved.
+func (config *S3ClientConfig) WriteEnvFile(path string) (err error) {
+	err = config.validate()
+	if err != nil {
+		return
+	}
+	values := config.Environment()
+	names := []string{"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "AWS_ENDPOINT_URL", "AWS_REGION"}
+
+	existing, err := readConfigFile(path)
+	if err != nil {
+		return
+	}
+	var buffer bytes.Buffer
+	written := map[string]bool{}
+	for _, line := range splitLines(existing) {
+		name := envLineName(line)
+		if _, managed := values[name]; managed {
+			if !written[name] {
+				buffer.WriteString(name + "=" + quoteEnvValue(values[name]) + "\n")
+				written[name] = true
+			}
+			continue
+		}
+		buffer.WriteString(line + "\n")
+	}
+	for _, name := range names {
+		if value, ok := values[name]; ok && !written[name] {
+			buffer.WriteString(name + "=" + quoteEnvValue(value) + "\n")
+		}
+	}
+	err = writeConfigFile(path, buffer.Bytes())
+	return
+}
+
+// Environment returns the AWS_* environment variables for the credentials and endpoint.
+func (config *S3ClientConfig) Environment() map[string]string {
+	environment := map[string]string{
+		"AWS_ACCESS_KEY_ID":     core.StringNilMapper(config.AccessKey),
+		"AWS_SECRET_ACCESS_KEY": core.StringNilMapper(config.SecretKey),
+		"AWS_ENDPOINT_URL":      core.StringNilMapper(config.Endpoint),
+	}
+	if config.Region != nil {
+		environment["AWS_REGION"] = *config.Region
+	}
+	return environment
+}
+
+func (config *S3ClientConfig) validate() (err error) {
+	err = core.ValidateStruct(config, "config")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+	}
+	return
+}
+
+func (config *S3ClientConfig) profile() string {
+	if config.Profile == nil || *config.Profile == "" {
+		return DefaultS3ClientProfile
+	}
+	return *config.Profile
+}
+
+type iniValue struct {
+	key   string
+	value string
+}
+
+var iniSectionPattern = regexp.MustCompile(`^\s*\[\s*([^\]]*?)\s*\]\s*$`)
+
+// mergeIniFile sets the values in one section of an INI file. Keys of that section that are not being set, and all
+// other sections and comments, are kept as they are.
+func mergeIniFile(path string, section string, values []iniValue) (err error) {
+	existing, err := readConfigFile(path)
+	if err != nil {
+		return
+	}
+
+	var buffer bytes.Buffer
+	written := map[string]bool{}
+	inSection, found := false, false
+	flush := func() {
+		for _, v := range values {
+			if !written[v.key] {
+				buffer.WriteString(v.key + " = " + v.value + "\n")
+				written[v.key] = true
+			}
+		}
+	}
+	lines := splitLines(existing)
+	for i, line := range lines {
+		if match := iniSectionPattern.FindStringSubmatch(line); match != nil {
+			if inSection {
+				flush()
+			}
+			inSection = match[1] == section
+			found = found || inSection
+			buffer.WriteString(line + "\n")
+			continue
+		}
+		if inSection {
+			key, _, isValue := strings.Cut(line, "=")
+			key = strings.TrimSpace(key)
+			if isValue && indexOfIniValue(values, key) >= 0 {
+				v := values[indexOfIniValue(values, key)]
+				if !written[key] {
+					buffer.WriteString(v.key + " = " + v.value + "\n")
+					written[key] = true
+				}
+				continue
+			}
+			// Keep new values ahead of the blank lines that separate this section from the next.
+			if strings.TrimSpace(line) == "" && restIsBlankOrSection(lines[i:]) {
+				flush()
+			}
+		}
+		buffer.WriteString(line + "\n")
+	}
+	if inSection {
+		flush()
+	}
+	if !found {
+		if buffer.Len() > 0 {
+			buffer.WriteString("\n")
+		}
+		buffer.WriteString("[" + section + "]\n")
+		flush()
+	}
+	err = writeConfigFile(path, buffer.Bytes())
+	return
+}
+
+func indexOfIniValue(values []iniValue, key string) int {
+	for i, v := range values {
+		if v.key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// restIsBlankOrSection returns true if the lines are blank up to the next section header or the end of the file.
+func restIsBlankOrSection(lines []string) bool {
+	for _, line := range lines {
+		if iniSectionPattern.MatchString(line) {
+			return true
+		}
+		if strings.TrimSpace(line) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func splitLines(data []byte) []string {
+	text := strings.TrimRight(string(data), "\n")
+	if text == "" {
+		return nil
+	}
+	return strings.Split(text, "\n")
+}
+
+func envLineName(line string) string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "export ")
+	name, _, ok := strings.Cut(line, "=")
+	if !ok {
+		return ""
+	}
+	return strings.TrimSpace(name)
+}
+
+var envSafeValuePattern = regexp.MustCompile(`^[A-Za-z0-9_./:@+=,-]*$`)
+
+func quoteEnvValue(value string) string {
+	if envSafeValuePattern.MatchString(value) {
+		return value
+	}
+	return "'" + strings.ReplaceAll(value, "'", `'\''`) + "'"
+}
+
+func readConfigFile(path string) (data []byte, err error) {
+	data, err = os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-config-error", common.GetComponentInfo())
+	}
+	return
+}
+
+func writeConfigFile(path string, data []byte) (err error) {
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err == nil {
+		err = writeFileAtomic(path, data, 0600)
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "write-config-error", common.GetComponentInfo())
+	}
+	return
+}
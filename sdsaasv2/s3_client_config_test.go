@@ -0,0 +1,154 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`S3ClientConfig`, func() {
+	var (
+		dir    string
+		config *sdsaasv2.S3ClientConfig
+	)
+	credentials := &sdsaasv2.AccessKeyResponse{
+		AccessKey: core.StringPtr("app-key"),
+		SecretKey: core.StringPtr("app/secret+key"),
+	}
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+		var err error
+		config, err = sdsaasv2.NewS3ClientConfig(credentials, "https://s3.example.com:8443")
+		Expect(err).To(BeNil())
+		config.SetProfile("sds").SetRegion("us-east")
+	})
+
+	readFile := func(path string) string {
+		data, err := os.ReadFile(path)
+		Expect(err).To(BeNil())
+		info, err := os.Stat(path)
+		Expect(err).To(BeNil())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		return string(data)
+	}
+	writeFile := func(path string, data string) {
+		Expect(os.WriteFile(path, []byte(data), 0644)).To(Succeed())
+	}
+
+	It(`Validates its input`, func() {
+		_, err := sdsaasv2.NewS3ClientConfig(nil, "https://s3.example.com")
+		Expect(err).ToNot(BeNil())
+		_, err = sdsaasv2.NewS3ClientConfig(&sdsaasv2.AccessKeyResponse{AccessKey: core.StringPtr("app-key")}, "https://s3.example.com")
+		Expect(err).ToNot(BeNil())
+		_, err = sdsaasv2.NewS3ClientConfig(credentials, "")
+		Expect(err).ToNot(BeNil())
+
+		config.Endpoint = core.StringPtr("s3.example.com")
+		Expect(config.WriteS3cmdConfig(filepath.Join(dir, ".s3cfg"))).ToNot(Succeed())
+	})
+	It(`Writes a new AWS profile`, func() {
+		credentialsPath := filepath.Join(dir, ".aws", "credentials")
+		configPath := filepath.Join(dir, ".aws", "config")
+		Expect(config.WriteAwsProfile(credentialsPath, configPath)).To(Succeed())
+
+		Expect(readFile(credentialsPath)).To(Equal("[sds]\n" +
+			"aws_access_key_id = app-key\n" +
+			"aws_secret_access_key = app/secret+key\n"))
+		Expect(readFile(configPath)).To(Equal("[profile sds]\n" +
+			"endpoint_url = https://s3.example.com:8443\n" +
+			"region = us-east\n"))
+	})
+	It(`Merges into existing AWS files without clobbering other profiles`, func() {
+		credentialsPath := filepath.Join(dir, "credentials")
+		writeFile(credentialsPath, "# managed by hand\n"+
+			"[default]\n"+
+			"aws_access_key_id = other-key\n"+
+			"aws_secret_access_key = other-secret\n"+
+			"\n"+
+			"[sds]\n"+
+			"aws_access_key_id = old-key\n"+
+			"aws_session_token = keep-me\n"+
+			"\n"+
+			"[backup]\n"+
+			"aws_access_key_id = backup-key\n")
+		Expect(config.WriteAwsProfile(credentialsPath, "")).To(Succeed())
+
+		Expect(readFile(credentialsPath)).To(Equal("# managed by hand\n" +
+			"[default]\n" +
+			"aws_access_key_id = other-key\n" +
+			"aws_secret_access_key = other-secret\n" +
+			"\n" +
+			"[sds]\n" +
+			"aws_access_key_id = app-key\n" +
+			"aws_session_token = keep-me\n" +
+			"aws_secret_access_key = app/secret+key\n" +
+			"\n" +
+			"[backup]\n" +
+			"aws_access_key_id = backup-key\n"))
+	})
+	It(`Writes an rclone remote`, func() {
+		path := filepath.Join(dir, "rclone.conf")
+		writeFile(path, "[local]\ntype = local\n")
+		Expect(config.WriteRcloneRemote(path)).To(Succeed())
+
+		Expect(readFile(path)).To(Equal("[local]\n" +
+			"type = local\n" +
+			"\n" +
+			"[sds]\n" +
+			"type = s3\n" +
+			"provider = Ceph\n" +
+			"env_auth = false\n" +
+			"access_key_id = app-key\n" +
+			"secret_access_key = app/secret+key\n" +
+			"endpoint = https://s3.example.com:8443\n" +
+			"region = us-east\n"))
+	})
+	It(`Writes an s3cmd configuration`, func() {
+		path := filepath.Join(dir, ".s3cfg")
+		config.Profile = nil
+		config.Endpoint = core.StringPtr("http://s3.example.com")
+		Expect(config.WriteS3cmdConfig(path)).To(Succeed())
+
+		Expect(readFile(path)).To(Equal("[default]\n" +
+			"access_key = app-key\n" +
+			"secret_key = app/secret+key\n" +
+			"host_base = s3.example.com\n" +
+			"host_bucket = s3.example.com\n" +
+			"use_https = False\n" +
+			"bucket_location = us-east\n"))
+	})
+	It(`Writes an environment file`, func() {
+		path := filepath.Join(dir, "s3.env")
+		writeFile(path, "EDITOR=vi\nexport AWS_ACCESS_KEY_ID=old-key\n")
+		config.SecretKey = core.StringPtr("it's secret")
+		Expect(config.WriteEnvFile(path)).To(Succeed())
+
+		Expect(readFile(path)).To(Equal("EDITOR=vi\n" +
+			"AWS_ACCESS_KEY_ID=app-key\n" +
+			"AWS_SECRET_ACCESS_KEY='it'\\''s secret'\n" +
+			"AWS_ENDPOINT_URL=https://s3.example.com:8443\n" +
+			"AWS_REGION=us-east\n"))
+		Expect(config.Environment()).To(HaveKeyWithValue("AWS_SECRET_ACCESS_KEY", "it's secret"))
+	})
+})
@@ -31,7 +31,7 @@ import (
 	"time"
 
 	"github.com/IBM/go-sdk-core/v5/core"
-	common "github.com/IBM/sds-go-sdk/common"
+	common "github.com/IBM/sds-go-sdk/v2/common"
 	"github.com/go-openapi/strfmt"
 )
 
@@ -40,6 +40,15 @@ import (
 // API Version: 2.0.0
 type SdsaasV2 struct {
 	Service *core.BaseService
+
+	// API versions pinned by operation ID or service family.
+	apiVersions map[string]string
+
+	// The name-to-ID cache of the lookups by name, when enabled.
+	lookupCache *lookupCache
+
+	// How the successful responses are validated, see SetResponseValidation; "" when they are not.
+	responseValidation string
 }
 
 // DefaultServiceName is the default key used to find external configuration information.
@@ -56,6 +65,10 @@ type SdsaasV2Options struct {
 	ServiceName   string
 	URL           string
 	Authenticator core.Authenticator
+
+	// API versions to send in the IBM-API-Version header, keyed by operation ID (e.g. "CreateSslCert") or by service
+	// family (ServiceFamilyBlock or ServiceFamilyObject). An operation's own entry takes precedence over its family's.
+	APIVersions map[string]string
 }
 
 // NewSdsaasV2UsingExternalConfig : constructs an instance of SdsaasV2 with passed in options and external configuration.
@@ -87,6 +100,13 @@ func NewSdsaasV2UsingExternalConfig(options *SdsaasV2Options) (sdsaas *SdsaasV2,
 	if options.URL != "" {
 		err = sdsaas.Service.SetServiceURL(options.URL)
 		err = core.RepurposeSDKProblem(err, "url-set-error")
+		return
+	}
+
+	err = sdsaas.configureEndpoint(options.ServiceName)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "endpoint-config-error", common.GetComponentInfo())
+		return
 	}
 	return
 }
@@ -114,13 +134,16 @@ func NewSdsaasV2(options *SdsaasV2Options) (service *SdsaasV2, err error) {
 	service = &SdsaasV2{
 		Service: baseService,
 	}
+	for operationOrFamily, version := range options.APIVersions {
+		service.SetAPIVersion(operationOrFamily, version)
+	}
 
 	return
 }
 
 // GetServiceURLForRegion returns the service URL to be used for the specified region
 func GetServiceURLForRegion(region string) (string, error) {
-	return "", core.SDKErrorf(nil, "service does not support regional URLs", "no-regional-support", common.GetComponentInfo())
+	return "", core.SDKErrorf(nil, "service URLs are specific to an instance; use EndpointResolver.ResolveServiceURL with the region and instance ID", "no-regional-support", common.GetComponentInfo())
 }
 
 // Clone makes a copy of "sdsaas" suitable for processing requests.
@@ -169,7 +192,11 @@ func (sdsaas *SdsaasV2) GetEnableGzipCompression() bool {
 
 // EnableRetries enables automatic retries for requests invoked for this service instance.
 // If either parameter is specified as 0, then a default value is used instead.
+// It replaces the RetryPolicy set by SetRetryPolicy, if any.
 func (sdsaas *SdsaasV2) EnableRetries(maxRetries int, maxRetryInterval time.Duration) {
+	if sdsaas.GetRetryPolicy() != nil {
+		sdsaas.SetRetryPolicy(nil)
+	}
 	sdsaas.Service.EnableRetries(maxRetries, maxRetryInterval)
 }
 
@@ -196,7 +223,7 @@ func (sdsaas *SdsaasV2) ListVolumesWithContext(ctx context.Context, listVolumesO
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListVolumes", `/volumes`, nil))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/volumes`, nil)
 	if err != nil {
@@ -204,7 +231,7 @@ func (sdsaas *SdsaasV2) ListVolumesWithContext(ctx context.Context, listVolumesO
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "ListVolumes")
+	sdkHeaders := sdsaas.getSdkHeaders("ListVolumes")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -244,6 +271,9 @@ func (sdsaas *SdsaasV2) ListVolumesWithContext(ctx context.Context, listVolumesO
 			return
 		}
 		response.Result = result
+		if err = sdsaas.validateResponse("ListVolumes", response, result); err != nil {
+			result = nil
+		}
 	}
 
 	return
@@ -274,7 +304,7 @@ func (sdsaas *SdsaasV2) CreateVolumeWithContext(ctx context.Context, createVolum
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateVolume", `/volumes`, nil))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/volumes`, nil)
 	if err != nil {
@@ -282,7 +312,7 @@ func (sdsaas *SdsaasV2) CreateVolumeWithContext(ctx context.Context, createVolum
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "CreateVolume")
+	sdkHeaders := sdsaas.getSdkHeaders("CreateVolume")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -332,6 +362,9 @@ func (sdsaas *SdsaasV2) CreateVolumeWithContext(ctx context.Context, createVolum
 			return
 		}
 		response.Result = result
+		if err = sdsaas.validateResponse("CreateVolume", response, result); err != nil {
+			result = nil
+		}
 	}
 
 	return
@@ -364,7 +397,7 @@ func (sdsaas *SdsaasV2) DeleteVolumeWithContext(ctx context.Context, deleteVolum
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteVolume", `/volumes/{id}`, pathParamsMap))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/volumes/{id}`, pathParamsMap)
 	if err != nil {
@@ -372,7 +405,7 @@ func (sdsaas *SdsaasV2) DeleteVolumeWithContext(ctx context.Context, deleteVolum
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "DeleteVolume")
+	sdkHeaders := sdsaas.getSdkHeaders("DeleteVolume")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -423,7 +456,7 @@ func (sdsaas *SdsaasV2) GetVolumeWithContext(ctx context.Context, getVolumeOptio
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetVolume", `/volumes/{id}`, pathParamsMap))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/volumes/{id}`, pathParamsMap)
 	if err != nil {
@@ -431,7 +464,7 @@ func (sdsaas *SdsaasV2) GetVolumeWithContext(ctx context.Context, getVolumeOptio
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "GetVolume")
+	sdkHeaders := sdsaas.getSdkHeaders("GetVolume")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -461,6 +494,9 @@ func (sdsaas *SdsaasV2) GetVolumeWithContext(ctx context.Context, getVolumeOptio
 			return
 		}
 		response.Result = result
+		if err = sdsaas.validateResponse("GetVolume", response, result); err != nil {
+			result = nil
+		}
 	}
 
 	return
@@ -493,7 +529,7 @@ func (sdsaas *SdsaasV2) UpdateVolumeWithContext(ctx context.Context, updateVolum
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "UpdateVolume", `/volumes/{id}`, pathParamsMap))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/volumes/{id}`, pathParamsMap)
 	if err != nil {
@@ -501,7 +537,7 @@ func (sdsaas *SdsaasV2) UpdateVolumeWithContext(ctx context.Context, updateVolum
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "UpdateVolume")
+	sdkHeaders := sdsaas.getSdkHeaders("UpdateVolume")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -538,6 +574,9 @@ func (sdsaas *SdsaasV2) UpdateVolumeWithContext(ctx context.Context, updateVolum
 			return
 		}
 		response.Result = result
+		if err = sdsaas.validateResponse("UpdateVolume", response, result); err != nil {
+			result = nil
+		}
 	}
 
 	return
@@ -561,7 +600,7 @@ func (sdsaas *SdsaasV2) ListHostsWithContext(ctx context.Context, listHostsOptio
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListHosts", `/hosts`, nil))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/hosts`, nil)
 	if err != nil {
@@ -569,7 +608,7 @@ func (sdsaas *SdsaasV2) ListHostsWithContext(ctx context.Context, listHostsOptio
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "ListHosts")
+	sdkHeaders := sdsaas.getSdkHeaders("ListHosts")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -609,6 +648,9 @@ func (sdsaas *SdsaasV2) ListHostsWithContext(ctx context.Context, listHostsOptio
 			return
 		}
 		response.Result = result
+		if err = sdsaas.validateResponse("ListHosts", response, result); err != nil {
+			result = nil
+		}
 	}
 
 	return
@@ -637,7 +679,7 @@ func (sdsaas *SdsaasV2) CreateHostWithContext(ctx context.Context, createHostOpt
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateHost", `/hosts`, nil))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/hosts`, nil)
 	if err != nil {
@@ -645,7 +687,7 @@ func (sdsaas *SdsaasV2) CreateHostWithContext(ctx context.Context, createHostOpt
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "CreateHost")
+	sdkHeaders := sdsaas.getSdkHeaders("CreateHost")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -695,6 +737,9 @@ func (sdsaas *SdsaasV2) CreateHostWithContext(ctx context.Context, createHostOpt
 			return
 		}
 		response.Result = result
+		if err = sdsaas.validateResponse("CreateHost", response, result); err != nil {
+			result = nil
+		}
 	}
 
 	return
@@ -726,7 +771,7 @@ func (sdsaas *SdsaasV2) DeleteHostWithContext(ctx context.Context, deleteHostOpt
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteHost", `/hosts/{id}`, pathParamsMap))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/hosts/{id}`, pathParamsMap)
 	if err != nil {
@@ -734,7 +779,7 @@ func (sdsaas *SdsaasV2) DeleteHostWithContext(ctx context.Context, deleteHostOpt
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "DeleteHost")
+	sdkHeaders := sdsaas.getSdkHeaders("DeleteHost")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -785,7 +830,7 @@ func (sdsaas *SdsaasV2) GetHostWithContext(ctx context.Context, getHostOptions *
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetHost", `/hosts/{id}`, pathParamsMap))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/hosts/{id}`, pathParamsMap)
 	if err != nil {
@@ -793,7 +838,7 @@ func (sdsaas *SdsaasV2) GetHostWithContext(ctx context.Context, getHostOptions *
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "GetHost")
+	sdkHeaders := sdsaas.getSdkHeaders("GetHost")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -823,6 +868,9 @@ func (sdsaas *SdsaasV2) GetHostWithContext(ctx context.Context, getHostOptions *
 			return
 		}
 		response.Result = result
+		if err = sdsaas.validateResponse("GetHost", response, result); err != nil {
+			result = nil
+		}
 	}
 
 	return
@@ -855,7 +903,7 @@ func (sdsaas *SdsaasV2) UpdateHostWithContext(ctx context.Context, updateHostOpt
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "UpdateHost", `/hosts/{id}`, pathParamsMap))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/hosts/{id}`, pathParamsMap)
 	if err != nil {
@@ -863,7 +911,7 @@ func (sdsaas *SdsaasV2) UpdateHostWithContext(ctx context.Context, updateHostOpt
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "UpdateHost")
+	sdkHeaders := sdsaas.getSdkHeaders("UpdateHost")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -900,6 +948,9 @@ func (sdsaas *SdsaasV2) UpdateHostWithContext(ctx context.Context, updateHostOpt
 			return
 		}
 		response.Result = result
+		if err = sdsaas.validateResponse("UpdateHost", response, result); err != nil {
+			result = nil
+		}
 	}
 
 	return
@@ -931,7 +982,7 @@ func (sdsaas *SdsaasV2) DeleteVolumeMappingsWithContext(ctx context.Context, del
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteVolumeMappings", `/hosts/{id}/volume_mappings`, pathParamsMap))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/hosts/{id}/volume_mappings`, pathParamsMap)
 	if err != nil {
@@ -939,7 +990,7 @@ func (sdsaas *SdsaasV2) DeleteVolumeMappingsWithContext(ctx context.Context, del
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "DeleteVolumeMappings")
+	sdkHeaders := sdsaas.getSdkHeaders("DeleteVolumeMappings")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -990,7 +1041,7 @@ func (sdsaas *SdsaasV2) ListVolumeMappingsWithContext(ctx context.Context, listV
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListVolumeMappings", `/hosts/{id}/volume_mappings`, pathParamsMap))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/hosts/{id}/volume_mappings`, pathParamsMap)
 	if err != nil {
@@ -998,7 +1049,7 @@ func (sdsaas *SdsaasV2) ListVolumeMappingsWithContext(ctx context.Context, listV
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "ListVolumeMappings")
+	sdkHeaders := sdsaas.getSdkHeaders("ListVolumeMappings")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1038,6 +1089,9 @@ func (sdsaas *SdsaasV2) ListVolumeMappingsWithContext(ctx context.Context, listV
 			return
 		}
 		response.Result = result
+		if err = sdsaas.validateResponse("ListVolumeMappings", response, result); err != nil {
+			result = nil
+		}
 	}
 
 	return
@@ -1069,7 +1123,7 @@ func (sdsaas *SdsaasV2) CreateVolumeMappingWithContext(ctx context.Context, crea
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateVolumeMapping", `/hosts/{id}/volume_mappings`, pathParamsMap))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/hosts/{id}/volume_mappings`, pathParamsMap)
 	if err != nil {
@@ -1077,7 +1131,7 @@ func (sdsaas *SdsaasV2) CreateVolumeMappingWithContext(ctx context.Context, crea
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "CreateVolumeMapping")
+	sdkHeaders := sdsaas.getSdkHeaders("CreateVolumeMapping")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1118,6 +1172,9 @@ func (sdsaas *SdsaasV2) CreateVolumeMappingWithContext(ctx context.Context, crea
 			return
 		}
 		response.Result = result
+		if err = sdsaas.validateResponse("CreateVolumeMapping", response, result); err != nil {
+			result = nil
+		}
 	}
 
 	return
@@ -1150,7 +1207,7 @@ func (sdsaas *SdsaasV2) DeleteVolumeMappingWithContext(ctx context.Context, dele
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteVolumeMapping", `/hosts/{id}/volume_mappings/{volume_mapping_id}`, pathParamsMap))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/hosts/{id}/volume_mappings/{volume_mapping_id}`, pathParamsMap)
 	if err != nil {
@@ -1158,7 +1215,7 @@ func (sdsaas *SdsaasV2) DeleteVolumeMappingWithContext(ctx context.Context, dele
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "DeleteVolumeMapping")
+	sdkHeaders := sdsaas.getSdkHeaders("DeleteVolumeMapping")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1210,7 +1267,7 @@ func (sdsaas *SdsaasV2) GetVolumeMappingWithContext(ctx context.Context, getVolu
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetVolumeMapping", `/hosts/{id}/volume_mappings/{volume_mapping_id}`, pathParamsMap))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/hosts/{id}/volume_mappings/{volume_mapping_id}`, pathParamsMap)
 	if err != nil {
@@ -1218,7 +1275,7 @@ func (sdsaas *SdsaasV2) GetVolumeMappingWithContext(ctx context.Context, getVolu
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "GetVolumeMapping")
+	sdkHeaders := sdsaas.getSdkHeaders("GetVolumeMapping")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1248,6 +1305,9 @@ func (sdsaas *SdsaasV2) GetVolumeMappingWithContext(ctx context.Context, getVolu
 			return
 		}
 		response.Result = result
+		if err = sdsaas.validateResponse("GetVolumeMapping", response, result); err != nil {
+			result = nil
+		}
 	}
 
 	return
@@ -1270,7 +1330,7 @@ func (sdsaas *SdsaasV2) ListHmacCredentialsWithContext(ctx context.Context, list
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListHmacCredentials", `/s3_credentials`, nil))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/s3_credentials`, nil)
 	if err != nil {
@@ -1278,7 +1338,7 @@ func (sdsaas *SdsaasV2) ListHmacCredentialsWithContext(ctx context.Context, list
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "ListHmacCredentials")
+	sdkHeaders := sdsaas.getSdkHeaders("ListHmacCredentials")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1308,6 +1368,9 @@ func (sdsaas *SdsaasV2) ListHmacCredentialsWithContext(ctx context.Context, list
 			return
 		}
 		response.Result = result
+		if err = sdsaas.validateResponse("ListHmacCredentials", response, result); err != nil {
+			result = nil
+		}
 	}
 
 	return
@@ -1339,7 +1402,7 @@ func (sdsaas *SdsaasV2) DeleteHmacCredentialsWithContext(ctx context.Context, de
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteHmacCredentials", `/s3_credentials/{access_key}`, pathParamsMap))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/s3_credentials/{access_key}`, pathParamsMap)
 	if err != nil {
@@ -1347,7 +1410,7 @@ func (sdsaas *SdsaasV2) DeleteHmacCredentialsWithContext(ctx context.Context, de
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "DeleteHmacCredentials")
+	sdkHeaders := sdsaas.getSdkHeaders("DeleteHmacCredentials")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1398,7 +1461,7 @@ func (sdsaas *SdsaasV2) CreateHmacCredentialsWithContext(ctx context.Context, cr
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateHmacCredentials", `/s3_credentials/{access_key}`, pathParamsMap))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/s3_credentials/{access_key}`, pathParamsMap)
 	if err != nil {
@@ -1406,7 +1469,7 @@ func (sdsaas *SdsaasV2) CreateHmacCredentialsWithContext(ctx context.Context, cr
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "CreateHmacCredentials")
+	sdkHeaders := sdsaas.getSdkHeaders("CreateHmacCredentials")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1436,6 +1499,9 @@ func (sdsaas *SdsaasV2) CreateHmacCredentialsWithContext(ctx context.Context, cr
 			return
 		}
 		response.Result = result
+		if err = sdsaas.validateResponse("CreateHmacCredentials", response, result); err != nil {
+			result = nil
+		}
 	}
 
 	return
@@ -1458,7 +1524,7 @@ func (sdsaas *SdsaasV2) ListCertificatesWithContext(ctx context.Context, listCer
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListCertificates", `/certificates`, nil))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/certificates`, nil)
 	if err != nil {
@@ -1466,7 +1532,7 @@ func (sdsaas *SdsaasV2) ListCertificatesWithContext(ctx context.Context, listCer
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "ListCertificates")
+	sdkHeaders := sdsaas.getSdkHeaders("ListCertificates")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1496,6 +1562,9 @@ func (sdsaas *SdsaasV2) ListCertificatesWithContext(ctx context.Context, listCer
 			return
 		}
 		response.Result = result
+		if err = sdsaas.validateResponse("ListCertificates", response, result); err != nil {
+			result = nil
+		}
 	}
 
 	return
@@ -1527,7 +1596,7 @@ func (sdsaas *SdsaasV2) DeleteSslCertWithContext(ctx context.Context, deleteSslC
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteSslCert", `/certificates/{cert_type}`, pathParamsMap))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/certificates/{cert_type}`, pathParamsMap)
 	if err != nil {
@@ -1535,7 +1604,7 @@ func (sdsaas *SdsaasV2) DeleteSslCertWithContext(ctx context.Context, deleteSslC
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "DeleteSslCert")
+	sdkHeaders := sdsaas.getSdkHeaders("DeleteSslCert")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1587,7 +1656,7 @@ func (sdsaas *SdsaasV2) GetS3SslCertStatusWithContext(ctx context.Context, getS3
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetS3SslCertStatus", `/certificates/{cert_type}`, pathParamsMap))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/certificates/{cert_type}`, pathParamsMap)
 	if err != nil {
@@ -1595,7 +1664,7 @@ func (sdsaas *SdsaasV2) GetS3SslCertStatusWithContext(ctx context.Context, getS3
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "GetS3SslCertStatus")
+	sdkHeaders := sdsaas.getSdkHeaders("GetS3SslCertStatus")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1625,6 +1694,9 @@ func (sdsaas *SdsaasV2) GetS3SslCertStatusWithContext(ctx context.Context, getS3
 			return
 		}
 		response.Result = result
+		if err = sdsaas.validateResponse("GetS3SslCertStatus", response, result); err != nil {
+			result = nil
+		}
 	}
 
 	return
@@ -1656,7 +1728,7 @@ func (sdsaas *SdsaasV2) CreateSslCertWithContext(ctx context.Context, createSslC
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateSslCert", `/certificates/{cert_type}`, pathParamsMap))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/certificates/{cert_type}`, pathParamsMap)
 	if err != nil {
@@ -1664,7 +1736,7 @@ func (sdsaas *SdsaasV2) CreateSslCertWithContext(ctx context.Context, createSslC
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "CreateSslCert")
+	sdkHeaders := sdsaas.getSdkHeaders("CreateSslCert")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1701,6 +1773,9 @@ func (sdsaas *SdsaasV2) CreateSslCertWithContext(ctx context.Context, createSslC
 			return
 		}
 		response.Result = result
+		if err = sdsaas.validateResponse("CreateSslCert", response, result); err != nil {
+			result = nil
+		}
 	}
 
 	return
@@ -1732,7 +1807,7 @@ func (sdsaas *SdsaasV2) ReplaceSslCertWithContext(ctx context.Context, replaceSs
 	}
 
 	builder := core.NewRequestBuilder(core.PUT)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ReplaceSslCert", `/certificates/{cert_type}`, pathParamsMap))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/certificates/{cert_type}`, pathParamsMap)
 	if err != nil {
@@ -1740,7 +1815,7 @@ func (sdsaas *SdsaasV2) ReplaceSslCertWithContext(ctx context.Context, replaceSs
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "ReplaceSslCert")
+	sdkHeaders := sdsaas.getSdkHeaders("ReplaceSslCert")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1777,6 +1852,9 @@ func (sdsaas *SdsaasV2) ReplaceSslCertWithContext(ctx context.Context, replaceSs
 			return
 		}
 		response.Result = result
+		if err = sdsaas.validateResponse("ReplaceSslCert", response, result); err != nil {
+			result = nil
+		}
 	}
 
 	return
@@ -1805,7 +1883,7 @@ func (sdsaas *SdsaasV2) DeleteSnapshotsWithContext(ctx context.Context, deleteSn
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteSnapshots", `/snapshots`, nil))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/snapshots`, nil)
 	if err != nil {
@@ -1813,7 +1891,7 @@ func (sdsaas *SdsaasV2) DeleteSnapshotsWithContext(ctx context.Context, deleteSn
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "DeleteSnapshots")
+	sdkHeaders := sdsaas.getSdkHeaders("DeleteSnapshots")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1860,7 +1938,7 @@ func (sdsaas *SdsaasV2) ListSnapshotsWithContext(ctx context.Context, listSnapsh
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "ListSnapshots", `/snapshots`, nil))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/snapshots`, nil)
 	if err != nil {
@@ -1868,7 +1946,7 @@ func (sdsaas *SdsaasV2) ListSnapshotsWithContext(ctx context.Context, listSnapsh
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "ListSnapshots")
+	sdkHeaders := sdsaas.getSdkHeaders("ListSnapshots")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1911,6 +1989,9 @@ func (sdsaas *SdsaasV2) ListSnapshotsWithContext(ctx context.Context, listSnapsh
 			return
 		}
 		response.Result = result
+		if err = sdsaas.validateResponse("ListSnapshots", response, result); err != nil {
+			result = nil
+		}
 	}
 
 	return
@@ -1943,7 +2024,7 @@ func (sdsaas *SdsaasV2) CreateSnapshotWithContext(ctx context.Context, createSna
 	}
 
 	builder := core.NewRequestBuilder(core.POST)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "CreateSnapshot", `/snapshots`, nil))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/snapshots`, nil)
 	if err != nil {
@@ -1951,7 +2032,7 @@ func (sdsaas *SdsaasV2) CreateSnapshotWithContext(ctx context.Context, createSna
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "CreateSnapshot")
+	sdkHeaders := sdsaas.getSdkHeaders("CreateSnapshot")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -1995,6 +2076,9 @@ func (sdsaas *SdsaasV2) CreateSnapshotWithContext(ctx context.Context, createSna
 			return
 		}
 		response.Result = result
+		if err = sdsaas.validateResponse("CreateSnapshot", response, result); err != nil {
+			result = nil
+		}
 	}
 
 	return
@@ -2027,7 +2111,7 @@ func (sdsaas *SdsaasV2) DeleteSnapshotWithContext(ctx context.Context, deleteSna
 	}
 
 	builder := core.NewRequestBuilder(core.DELETE)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "DeleteSnapshot", `/snapshots/{id}`, pathParamsMap))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/snapshots/{id}`, pathParamsMap)
 	if err != nil {
@@ -2035,7 +2119,7 @@ func (sdsaas *SdsaasV2) DeleteSnapshotWithContext(ctx context.Context, deleteSna
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "DeleteSnapshot")
+	sdkHeaders := sdsaas.getSdkHeaders("DeleteSnapshot")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2088,7 +2172,7 @@ func (sdsaas *SdsaasV2) GetSnapshotWithContext(ctx context.Context, getSnapshotO
 	}
 
 	builder := core.NewRequestBuilder(core.GET)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "GetSnapshot", `/snapshots/{id}`, pathParamsMap))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/snapshots/{id}`, pathParamsMap)
 	if err != nil {
@@ -2096,7 +2180,7 @@ func (sdsaas *SdsaasV2) GetSnapshotWithContext(ctx context.Context, getSnapshotO
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "GetSnapshot")
+	sdkHeaders := sdsaas.getSdkHeaders("GetSnapshot")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2126,6 +2210,9 @@ func (sdsaas *SdsaasV2) GetSnapshotWithContext(ctx context.Context, getSnapshotO
 			return
 		}
 		response.Result = result
+		if err = sdsaas.validateResponse("GetSnapshot", response, result); err != nil {
+			result = nil
+		}
 	}
 
 	return
@@ -2157,7 +2244,7 @@ func (sdsaas *SdsaasV2) UpdateSnapshotWithContext(ctx context.Context, updateSna
 	}
 
 	builder := core.NewRequestBuilder(core.PATCH)
-	builder = builder.WithContext(ctx)
+	builder = builder.WithContext(withOperation(ctx, "UpdateSnapshot", `/snapshots/{id}`, pathParamsMap))
 	builder.EnableGzipCompression = sdsaas.GetEnableGzipCompression()
 	_, err = builder.ResolveRequestURL(sdsaas.Service.Options.URL, `/snapshots/{id}`, pathParamsMap)
 	if err != nil {
@@ -2165,7 +2252,7 @@ func (sdsaas *SdsaasV2) UpdateSnapshotWithContext(ctx context.Context, updateSna
 		return
 	}
 
-	sdkHeaders := common.GetSdkHeaders("sdsaas", "V2", "UpdateSnapshot")
+	sdkHeaders := sdsaas.getSdkHeaders("UpdateSnapshot")
 	for headerName, headerValue := range sdkHeaders {
 		builder.AddHeader(headerName, headerValue)
 	}
@@ -2202,6 +2289,9 @@ func (sdsaas *SdsaasV2) UpdateSnapshotWithContext(ctx context.Context, updateSna
 			return
 		}
 		response.Result = result
+		if err = sdsaas.validateResponse("UpdateSnapshot", response, result); err != nil {
+			result = nil
+		}
 	}
 
 	return
@@ -2217,6 +2307,56 @@ type AccessKeyResponse struct {
 
 	// The generated secret key for the newly created HMAC credential.
 	SecretKey *string `json:"secret_key,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of AccessKeyResponse
+func (o *AccessKeyResponse) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of AccessKeyResponse
+func (o *AccessKeyResponse) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of AccessKeyResponse
+func (o *AccessKeyResponse) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of AccessKeyResponse
+func (o *AccessKeyResponse) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of AccessKeyResponse
+func (o *AccessKeyResponse) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.AccessKey != nil {
+		m["access_key"] = o.AccessKey
+	}
+	if o.SecretKey != nil {
+		m["secret_key"] = o.SecretKey
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalAccessKeyResponse unmarshals an instance of AccessKeyResponse from the specified map of raw messages.
@@ -2227,11 +2367,22 @@ func UnmarshalAccessKeyResponse(m map[string]json.RawMessage, result interface{}
 		err = core.SDKErrorf(err, "", "access_key-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "access_key")
 	err = core.UnmarshalPrimitive(m, "secret_key", &obj.SecretKey)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "secret_key-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "secret_key")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2240,6 +2391,53 @@ func UnmarshalAccessKeyResponse(m map[string]json.RawMessage, result interface{}
 type CertListResponse struct {
 	// The current list of configured certificates.
 	Certificates []string `json:"certificates" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of CertListResponse
+func (o *CertListResponse) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of CertListResponse
+func (o *CertListResponse) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of CertListResponse
+func (o *CertListResponse) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of CertListResponse
+func (o *CertListResponse) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of CertListResponse
+func (o *CertListResponse) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Certificates != nil {
+		m["certificates"] = o.Certificates
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalCertListResponse unmarshals an instance of CertListResponse from the specified map of raw messages.
@@ -2250,6 +2448,16 @@ func UnmarshalCertListResponse(m map[string]json.RawMessage, result interface{})
 		err = core.SDKErrorf(err, "", "certificates-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "certificates")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2272,6 +2480,65 @@ type CertResponse struct {
 
 	// When set to true, indicates that the provided key for the certificate is valid.
 	ValidKey *bool `json:"valid_key,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of CertResponse
+func (o *CertResponse) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of CertResponse
+func (o *CertResponse) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of CertResponse
+func (o *CertResponse) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of CertResponse
+func (o *CertResponse) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of CertResponse
+func (o *CertResponse) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Errors != nil {
+		m["errors"] = o.Errors
+	}
+	if o.Name != nil {
+		m["name"] = o.Name
+	}
+	if o.Trace != nil {
+		m["trace"] = o.Trace
+	}
+	if o.ValidCertificate != nil {
+		m["valid_certificate"] = o.ValidCertificate
+	}
+	if o.ValidKey != nil {
+		m["valid_key"] = o.ValidKey
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalCertResponse unmarshals an instance of CertResponse from the specified map of raw messages.
@@ -2282,26 +2549,40 @@ func UnmarshalCertResponse(m map[string]json.RawMessage, result interface{}) (er
 		err = core.SDKErrorf(err, "", "errors-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "errors")
 	err = core.UnmarshalPrimitive(m, "name", &obj.Name)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "name-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "name")
 	err = core.UnmarshalPrimitive(m, "trace", &obj.Trace)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "trace-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "trace")
 	err = core.UnmarshalPrimitive(m, "valid_certificate", &obj.ValidCertificate)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "valid_certificate-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "valid_certificate")
 	err = core.UnmarshalPrimitive(m, "valid_key", &obj.ValidKey)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "valid_key-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "valid_key")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2809,6 +3090,9 @@ type ErrorObject struct {
 
 	// A link to the relevant documentation that contains more information about this error.
 	MoreInfo *string `json:"more_info" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // Constants associated with the ErrorObject.Code property.
@@ -2833,6 +3117,56 @@ const (
 	ErrorObjectCodeZoneNotPrimaryConst = "zone_not_primary"
 )
 
+// SetProperty allows the user to set an arbitrary property on an instance of ErrorObject
+func (o *ErrorObject) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of ErrorObject
+func (o *ErrorObject) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of ErrorObject
+func (o *ErrorObject) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of ErrorObject
+func (o *ErrorObject) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of ErrorObject
+func (o *ErrorObject) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Code != nil {
+		m["code"] = o.Code
+	}
+	if o.Message != nil {
+		m["message"] = o.Message
+	}
+	if o.MoreInfo != nil {
+		m["more_info"] = o.MoreInfo
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
+}
+
 // UnmarshalErrorObject unmarshals an instance of ErrorObject from the specified map of raw messages.
 func UnmarshalErrorObject(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(ErrorObject)
@@ -2841,16 +3175,28 @@ func UnmarshalErrorObject(m map[string]json.RawMessage, result interface{}) (err
 		err = core.SDKErrorf(err, "", "code-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "code")
 	err = core.UnmarshalPrimitive(m, "message", &obj.Message)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "message-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "message")
 	err = core.UnmarshalPrimitive(m, "more_info", &obj.MoreInfo)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "more_info-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "more_info")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -2862,6 +3208,56 @@ type Gateway struct {
 
 	// Port number of the NVMe gateway.
 	Port *int64 `json:"port" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of Gateway
+func (o *Gateway) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of Gateway
+func (o *Gateway) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of Gateway
+func (o *Gateway) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of Gateway
+func (o *Gateway) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Gateway
+func (o *Gateway) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.IPAddress != nil {
+		m["ip_address"] = o.IPAddress
+	}
+	if o.Port != nil {
+		m["port"] = o.Port
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalGateway unmarshals an instance of Gateway from the specified map of raw messages.
@@ -2872,11 +3268,22 @@ func UnmarshalGateway(m map[string]json.RawMessage, result interface{}) (err err
 		err = core.SDKErrorf(err, "", "ip_address-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "ip_address")
 	err = core.UnmarshalPrimitive(m, "port", &obj.Port)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "port-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "port")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3053,6 +3460,71 @@ type Host struct {
 
 	// List of volume mappings for this host.
 	VolumeMappings []VolumeMapping `json:"volume_mappings" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of Host
+func (o *Host) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of Host
+func (o *Host) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of Host
+func (o *Host) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of Host
+func (o *Host) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Host
+func (o *Host) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.CreatedAt != nil {
+		m["created_at"] = o.CreatedAt
+	}
+	if o.ID != nil {
+		m["id"] = o.ID
+	}
+	if o.Href != nil {
+		m["href"] = o.Href
+	}
+	if o.Name != nil {
+		m["name"] = o.Name
+	}
+	if o.Nqn != nil {
+		m["nqn"] = o.Nqn
+	}
+	if o.PskEnabled != nil {
+		m["psk_enabled"] = o.PskEnabled
+	}
+	if o.VolumeMappings != nil {
+		m["volume_mappings"] = o.VolumeMappings
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalHost unmarshals an instance of Host from the specified map of raw messages.
@@ -3063,36 +3535,52 @@ func UnmarshalHost(m map[string]json.RawMessage, result interface{}) (err error)
 		err = core.SDKErrorf(err, "", "created_at-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "created_at")
 	err = core.UnmarshalPrimitive(m, "id", &obj.ID)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "id")
 	err = core.UnmarshalPrimitive(m, "href", &obj.Href)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "href-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "href")
 	err = core.UnmarshalPrimitive(m, "name", &obj.Name)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "name-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "name")
 	err = core.UnmarshalPrimitive(m, "nqn", &obj.Nqn)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "nqn-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "nqn")
 	err = core.UnmarshalPrimitive(m, "psk_enabled", &obj.PskEnabled)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "psk_enabled-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "psk_enabled")
 	err = core.UnmarshalModel(m, "volume_mappings", &obj.VolumeMappings, UnmarshalVolumeMapping)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "volume_mappings-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "volume_mappings")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3115,6 +3603,65 @@ type HostCollection struct {
 	//     Example:
 	//       132.
 	TotalCount *int64 `json:"total_count" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of HostCollection
+func (o *HostCollection) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of HostCollection
+func (o *HostCollection) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of HostCollection
+func (o *HostCollection) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of HostCollection
+func (o *HostCollection) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of HostCollection
+func (o *HostCollection) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.First != nil {
+		m["first"] = o.First
+	}
+	if o.Hosts != nil {
+		m["hosts"] = o.Hosts
+	}
+	if o.Limit != nil {
+		m["limit"] = o.Limit
+	}
+	if o.Next != nil {
+		m["next"] = o.Next
+	}
+	if o.TotalCount != nil {
+		m["total_count"] = o.TotalCount
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalHostCollection unmarshals an instance of HostCollection from the specified map of raw messages.
@@ -3125,26 +3672,40 @@ func UnmarshalHostCollection(m map[string]json.RawMessage, result interface{}) (
 		err = core.SDKErrorf(err, "", "first-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "first")
 	err = core.UnmarshalModel(m, "hosts", &obj.Hosts, UnmarshalHost)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "hosts-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "hosts")
 	err = core.UnmarshalPrimitive(m, "limit", &obj.Limit)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "limit-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "limit")
 	err = core.UnmarshalModel(m, "next", &obj.Next, UnmarshalPageLink)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "next-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "next")
 	err = core.UnmarshalPrimitive(m, "total_count", &obj.TotalCount)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "total_count-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "total_count")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3168,6 +3729,53 @@ func (resp *HostCollection) GetNextStart() (*string, error) {
 type HostPatch struct {
 	// The unique name for this resource.
 	Name *string `json:"name,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of HostPatch
+func (o *HostPatch) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of HostPatch
+func (o *HostPatch) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of HostPatch
+func (o *HostPatch) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of HostPatch
+func (o *HostPatch) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of HostPatch
+func (o *HostPatch) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Name != nil {
+		m["name"] = o.Name
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalHostPatch unmarshals an instance of HostPatch from the specified map of raw messages.
@@ -3178,6 +3786,16 @@ func UnmarshalHostPatch(m map[string]json.RawMessage, result interface{}) (err e
 		err = core.SDKErrorf(err, "", "name-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "name")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3185,6 +3803,9 @@ func UnmarshalHostPatch(m map[string]json.RawMessage, result interface{}) (err e
 // AsPatch returns a generic map representation of the HostPatch
 func (hostPatch *HostPatch) AsPatch() (_patch map[string]interface{}, err error) {
 	_patch = map[string]interface{}{}
+	for k, v := range hostPatch.additionalProperties {
+		_patch[k] = v
+	}
 	if !core.IsNil(hostPatch.Name) {
 		_patch["name"] = hostPatch.Name
 	}
@@ -3202,6 +3823,59 @@ type HostReference struct {
 
 	// The NQN (NVMe Qualified Name) as configured on the initiator (compute/host) accessing the storage.
 	Nqn *string `json:"nqn" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of HostReference
+func (o *HostReference) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of HostReference
+func (o *HostReference) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of HostReference
+func (o *HostReference) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of HostReference
+func (o *HostReference) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of HostReference
+func (o *HostReference) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.ID != nil {
+		m["id"] = o.ID
+	}
+	if o.Name != nil {
+		m["name"] = o.Name
+	}
+	if o.Nqn != nil {
+		m["nqn"] = o.Nqn
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalHostReference unmarshals an instance of HostReference from the specified map of raw messages.
@@ -3212,16 +3886,28 @@ func UnmarshalHostReference(m map[string]json.RawMessage, result interface{}) (e
 		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "id")
 	err = core.UnmarshalPrimitive(m, "name", &obj.Name)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "name-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "name")
 	err = core.UnmarshalPrimitive(m, "nqn", &obj.Nqn)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "nqn-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "nqn")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3248,46 +3934,127 @@ type HostSummary struct {
 
 	// List of volume mappings for this host.
 	VolumeMappings []VolumeMappingReference `json:"volume_mappings" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
-// UnmarshalHostSummary unmarshals an instance of HostSummary from the specified map of raw messages.
-func UnmarshalHostSummary(m map[string]json.RawMessage, result interface{}) (err error) {
-	obj := new(HostSummary)
-	err = core.UnmarshalPrimitive(m, "created_at", &obj.CreatedAt)
+// SetProperty allows the user to set an arbitrary property on an instance of HostSummary
+func (o *HostSummary) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of HostSummary
+func (o *HostSummary) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of HostSummary
+func (o *HostSummary) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of HostSummary
+func (o *HostSummary) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of HostSummary
+func (o *HostSummary) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.CreatedAt != nil {
+		m["created_at"] = o.CreatedAt
+	}
+	if o.ID != nil {
+		m["id"] = o.ID
+	}
+	if o.Href != nil {
+		m["href"] = o.Href
+	}
+	if o.Name != nil {
+		m["name"] = o.Name
+	}
+	if o.Nqn != nil {
+		m["nqn"] = o.Nqn
+	}
+	if o.PskEnabled != nil {
+		m["psk_enabled"] = o.PskEnabled
+	}
+	if o.VolumeMappings != nil {
+		m["volume_mappings"] = o.VolumeMappings
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
+}
+
+// UnmarshalHostSummary unmarshals an instance of HostSummary from the specified map of raw messages.
+func UnmarshalHostSummary(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(HostSummary)
+	err = core.UnmarshalPrimitive(m, "created_at", &obj.CreatedAt)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "created_at-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "created_at")
 	err = core.UnmarshalPrimitive(m, "id", &obj.ID)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "id")
 	err = core.UnmarshalPrimitive(m, "href", &obj.Href)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "href-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "href")
 	err = core.UnmarshalPrimitive(m, "name", &obj.Name)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "name-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "name")
 	err = core.UnmarshalPrimitive(m, "nqn", &obj.Nqn)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "nqn-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "nqn")
 	err = core.UnmarshalPrimitive(m, "psk_enabled", &obj.PskEnabled)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "psk_enabled-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "psk_enabled")
 	err = core.UnmarshalModel(m, "volume_mappings", &obj.VolumeMappings, UnmarshalVolumeMappingReference)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "volume_mappings-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "volume_mappings")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3531,6 +4298,56 @@ type Namespace struct {
 
 	// UUID of the NVMe namespace.
 	UUID *string `json:"uuid" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of Namespace
+func (o *Namespace) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of Namespace
+func (o *Namespace) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of Namespace
+func (o *Namespace) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of Namespace
+func (o *Namespace) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Namespace
+func (o *Namespace) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.ID != nil {
+		m["id"] = o.ID
+	}
+	if o.UUID != nil {
+		m["uuid"] = o.UUID
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalNamespace unmarshals an instance of Namespace from the specified map of raw messages.
@@ -3541,11 +4358,22 @@ func UnmarshalNamespace(m map[string]json.RawMessage, result interface{}) (err e
 		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "id")
 	err = core.UnmarshalPrimitive(m, "uuid", &obj.UUID)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "uuid-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "uuid")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3554,6 +4382,53 @@ func UnmarshalNamespace(m map[string]json.RawMessage, result interface{}) (err e
 type PageLink struct {
 	// The URL for a page of resources.
 	Href *string `json:"href,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of PageLink
+func (o *PageLink) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of PageLink
+func (o *PageLink) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of PageLink
+func (o *PageLink) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of PageLink
+func (o *PageLink) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of PageLink
+func (o *PageLink) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Href != nil {
+		m["href"] = o.Href
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalPageLink unmarshals an instance of PageLink from the specified map of raw messages.
@@ -3564,6 +4439,16 @@ func UnmarshalPageLink(m map[string]json.RawMessage, result interface{}) (err er
 		err = core.SDKErrorf(err, "", "href-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "href")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3639,6 +4524,80 @@ type Snapshot struct {
 
 	// The source volume object of this snapshot should be created.
 	SourceVolume *SourceVolume `json:"source_volume,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of Snapshot
+func (o *Snapshot) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of Snapshot
+func (o *Snapshot) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of Snapshot
+func (o *Snapshot) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of Snapshot
+func (o *Snapshot) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Snapshot
+func (o *Snapshot) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.ID != nil {
+		m["id"] = o.ID
+	}
+	if o.Href != nil {
+		m["href"] = o.Href
+	}
+	if o.Name != nil {
+		m["name"] = o.Name
+	}
+	if o.CreatedAt != nil {
+		m["created_at"] = o.CreatedAt
+	}
+	if o.ResourceType != nil {
+		m["resource_type"] = o.ResourceType
+	}
+	if o.LifecycleState != nil {
+		m["lifecycle_state"] = o.LifecycleState
+	}
+	if o.Size != nil {
+		m["size"] = o.Size
+	}
+	if o.MinimumCapacity != nil {
+		m["minimum_capacity"] = o.MinimumCapacity
+	}
+	if o.Deletable != nil {
+		m["deletable"] = o.Deletable
+	}
+	if o.SourceVolume != nil {
+		m["source_volume"] = o.SourceVolume
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalSnapshot unmarshals an instance of Snapshot from the specified map of raw messages.
@@ -3649,51 +4608,70 @@ func UnmarshalSnapshot(m map[string]json.RawMessage, result interface{}) (err er
 		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "id")
 	err = core.UnmarshalPrimitive(m, "href", &obj.Href)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "href-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "href")
 	err = core.UnmarshalPrimitive(m, "name", &obj.Name)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "name-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "name")
 	err = core.UnmarshalPrimitive(m, "created_at", &obj.CreatedAt)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "created_at-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "created_at")
 	err = core.UnmarshalPrimitive(m, "resource_type", &obj.ResourceType)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "resource_type-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "resource_type")
 	err = core.UnmarshalPrimitive(m, "lifecycle_state", &obj.LifecycleState)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "lifecycle_state-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "lifecycle_state")
 	err = core.UnmarshalPrimitive(m, "size", &obj.Size)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "size-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "size")
 	err = core.UnmarshalPrimitive(m, "minimum_capacity", &obj.MinimumCapacity)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "minimum_capacity-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "minimum_capacity")
 	err = core.UnmarshalPrimitive(m, "deletable", &obj.Deletable)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "deletable-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "deletable")
 	err = core.UnmarshalModel(m, "source_volume", &obj.SourceVolume, UnmarshalSourceVolume)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "source_volume-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "source_volume")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3718,6 +4696,65 @@ type SnapshotCollection struct {
 	//     Example:
 	//       132.
 	TotalCount *int64 `json:"total_count" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of SnapshotCollection
+func (o *SnapshotCollection) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of SnapshotCollection
+func (o *SnapshotCollection) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of SnapshotCollection
+func (o *SnapshotCollection) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of SnapshotCollection
+func (o *SnapshotCollection) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of SnapshotCollection
+func (o *SnapshotCollection) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Snapshots != nil {
+		m["snapshots"] = o.Snapshots
+	}
+	if o.First != nil {
+		m["first"] = o.First
+	}
+	if o.Limit != nil {
+		m["limit"] = o.Limit
+	}
+	if o.Next != nil {
+		m["next"] = o.Next
+	}
+	if o.TotalCount != nil {
+		m["total_count"] = o.TotalCount
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalSnapshotCollection unmarshals an instance of SnapshotCollection from the specified map of raw messages.
@@ -3728,26 +4765,40 @@ func UnmarshalSnapshotCollection(m map[string]json.RawMessage, result interface{
 		err = core.SDKErrorf(err, "", "snapshots-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "snapshots")
 	err = core.UnmarshalModel(m, "first", &obj.First, UnmarshalPageLink)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "first-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "first")
 	err = core.UnmarshalPrimitive(m, "limit", &obj.Limit)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "limit-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "limit")
 	err = core.UnmarshalModel(m, "next", &obj.Next, UnmarshalPageLink)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "next-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "next")
 	err = core.UnmarshalPrimitive(m, "total_count", &obj.TotalCount)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "total_count-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "total_count")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3771,6 +4822,53 @@ func (resp *SnapshotCollection) GetNextStart() (*string, error) {
 type SnapshotPatch struct {
 	// The name for this snapshot. The name must not be used by another snapshot.
 	Name *string `json:"name,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of SnapshotPatch
+func (o *SnapshotPatch) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of SnapshotPatch
+func (o *SnapshotPatch) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of SnapshotPatch
+func (o *SnapshotPatch) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of SnapshotPatch
+func (o *SnapshotPatch) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of SnapshotPatch
+func (o *SnapshotPatch) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Name != nil {
+		m["name"] = o.Name
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalSnapshotPatch unmarshals an instance of SnapshotPatch from the specified map of raw messages.
@@ -3781,6 +4879,16 @@ func UnmarshalSnapshotPatch(m map[string]json.RawMessage, result interface{}) (e
 		err = core.SDKErrorf(err, "", "name-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "name")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3788,6 +4896,9 @@ func UnmarshalSnapshotPatch(m map[string]json.RawMessage, result interface{}) (e
 // AsPatch returns a generic map representation of the SnapshotPatch
 func (snapshotPatch *SnapshotPatch) AsPatch() (_patch map[string]interface{}, err error) {
 	_patch = map[string]interface{}{}
+	for k, v := range snapshotPatch.additionalProperties {
+		_patch[k] = v
+	}
 	if !core.IsNil(snapshotPatch.Name) {
 		_patch["name"] = snapshotPatch.Name
 	}
@@ -3799,6 +4910,9 @@ func (snapshotPatch *SnapshotPatch) AsPatch() (_patch map[string]interface{}, er
 type SourceSnapshot struct {
 	// The unique identifier for this resource.
 	ID *string `json:"id" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewSourceSnapshot : Instantiate SourceSnapshot (Generic Model Constructor)
@@ -3813,6 +4927,50 @@ func (*SdsaasV2) NewSourceSnapshot(id string) (_model *SourceSnapshot, err error
 	return
 }
 
+// SetProperty allows the user to set an arbitrary property on an instance of SourceSnapshot
+func (o *SourceSnapshot) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of SourceSnapshot
+func (o *SourceSnapshot) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of SourceSnapshot
+func (o *SourceSnapshot) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of SourceSnapshot
+func (o *SourceSnapshot) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of SourceSnapshot
+func (o *SourceSnapshot) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.ID != nil {
+		m["id"] = o.ID
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
+}
+
 // UnmarshalSourceSnapshot unmarshals an instance of SourceSnapshot from the specified map of raw messages.
 func UnmarshalSourceSnapshot(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(SourceSnapshot)
@@ -3821,6 +4979,16 @@ func UnmarshalSourceSnapshot(m map[string]json.RawMessage, result interface{}) (
 		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "id")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3835,6 +5003,59 @@ type SourceVolume struct {
 
 	// The type of this resource.
 	ResourceType *string `json:"resource_type,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of SourceVolume
+func (o *SourceVolume) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of SourceVolume
+func (o *SourceVolume) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of SourceVolume
+func (o *SourceVolume) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of SourceVolume
+func (o *SourceVolume) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of SourceVolume
+func (o *SourceVolume) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.ID != nil {
+		m["id"] = o.ID
+	}
+	if o.Name != nil {
+		m["name"] = o.Name
+	}
+	if o.ResourceType != nil {
+		m["resource_type"] = o.ResourceType
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalSourceVolume unmarshals an instance of SourceVolume from the specified map of raw messages.
@@ -3845,16 +5066,28 @@ func UnmarshalSourceVolume(m map[string]json.RawMessage, result interface{}) (er
 		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "id")
 	err = core.UnmarshalPrimitive(m, "name", &obj.Name)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "name-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "name")
 	err = core.UnmarshalPrimitive(m, "resource_type", &obj.ResourceType)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "resource_type-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "resource_type")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3866,6 +5099,9 @@ type SourceVolumeGroupSnapshot struct {
 
 	// The volume to restore from VolumeGroupSnapshot.
 	Volume *SourceVolumeGroupSnapshotVolume `json:"volume" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewSourceVolumeGroupSnapshot : Instantiate SourceVolumeGroupSnapshot (Generic Model Constructor)
@@ -3881,6 +5117,53 @@ func (*SdsaasV2) NewSourceVolumeGroupSnapshot(id string, volume *SourceVolumeGro
 	return
 }
 
+// SetProperty allows the user to set an arbitrary property on an instance of SourceVolumeGroupSnapshot
+func (o *SourceVolumeGroupSnapshot) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of SourceVolumeGroupSnapshot
+func (o *SourceVolumeGroupSnapshot) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of SourceVolumeGroupSnapshot
+func (o *SourceVolumeGroupSnapshot) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of SourceVolumeGroupSnapshot
+func (o *SourceVolumeGroupSnapshot) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of SourceVolumeGroupSnapshot
+func (o *SourceVolumeGroupSnapshot) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.ID != nil {
+		m["id"] = o.ID
+	}
+	if o.Volume != nil {
+		m["volume"] = o.Volume
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
+}
+
 // UnmarshalSourceVolumeGroupSnapshot unmarshals an instance of SourceVolumeGroupSnapshot from the specified map of raw messages.
 func UnmarshalSourceVolumeGroupSnapshot(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(SourceVolumeGroupSnapshot)
@@ -3889,11 +5172,22 @@ func UnmarshalSourceVolumeGroupSnapshot(m map[string]json.RawMessage, result int
 		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "id")
 	err = core.UnmarshalModel(m, "volume", &obj.Volume, UnmarshalSourceVolumeGroupSnapshotVolume)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "volume-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "volume")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3902,6 +5196,9 @@ func UnmarshalSourceVolumeGroupSnapshot(m map[string]json.RawMessage, result int
 type SourceVolumeGroupSnapshotVolume struct {
 	// The unique identifier for this resource.
 	ID *string `json:"id" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewSourceVolumeGroupSnapshotVolume : Instantiate SourceVolumeGroupSnapshotVolume (Generic Model Constructor)
@@ -3916,6 +5213,50 @@ func (*SdsaasV2) NewSourceVolumeGroupSnapshotVolume(id string) (_model *SourceVo
 	return
 }
 
+// SetProperty allows the user to set an arbitrary property on an instance of SourceVolumeGroupSnapshotVolume
+func (o *SourceVolumeGroupSnapshotVolume) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of SourceVolumeGroupSnapshotVolume
+func (o *SourceVolumeGroupSnapshotVolume) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of SourceVolumeGroupSnapshotVolume
+func (o *SourceVolumeGroupSnapshotVolume) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of SourceVolumeGroupSnapshotVolume
+func (o *SourceVolumeGroupSnapshotVolume) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of SourceVolumeGroupSnapshotVolume
+func (o *SourceVolumeGroupSnapshotVolume) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.ID != nil {
+		m["id"] = o.ID
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
+}
+
 // UnmarshalSourceVolumeGroupSnapshotVolume unmarshals an instance of SourceVolumeGroupSnapshotVolume from the specified map of raw messages.
 func UnmarshalSourceVolumeGroupSnapshotVolume(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(SourceVolumeGroupSnapshotVolume)
@@ -3924,6 +5265,16 @@ func UnmarshalSourceVolumeGroupSnapshotVolume(m map[string]json.RawMessage, resu
 		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "id")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3932,6 +5283,9 @@ func UnmarshalSourceVolumeGroupSnapshotVolume(m map[string]json.RawMessage, resu
 type SourceVolumePrototype struct {
 	// The unique identifier for this resource.
 	ID *string `json:"id" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewSourceVolumePrototype : Instantiate SourceVolumePrototype (Generic Model Constructor)
@@ -3946,15 +5300,69 @@ func (*SdsaasV2) NewSourceVolumePrototype(id string) (_model *SourceVolumeProtot
 	return
 }
 
-// UnmarshalSourceVolumePrototype unmarshals an instance of SourceVolumePrototype from the specified map of raw messages.
-func UnmarshalSourceVolumePrototype(m map[string]json.RawMessage, result interface{}) (err error) {
-	obj := new(SourceVolumePrototype)
-	err = core.UnmarshalPrimitive(m, "id", &obj.ID)
-	if err != nil {
-		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
-		return
+// SetProperty allows the user to set an arbitrary property on an instance of SourceVolumePrototype
+func (o *SourceVolumePrototype) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
 	}
-	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of SourceVolumePrototype
+func (o *SourceVolumePrototype) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of SourceVolumePrototype
+func (o *SourceVolumePrototype) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of SourceVolumePrototype
+func (o *SourceVolumePrototype) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of SourceVolumePrototype
+func (o *SourceVolumePrototype) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.ID != nil {
+		m["id"] = o.ID
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
+}
+
+// UnmarshalSourceVolumePrototype unmarshals an instance of SourceVolumePrototype from the specified map of raw messages.
+func UnmarshalSourceVolumePrototype(m map[string]json.RawMessage, result interface{}) (err error) {
+	obj := new(SourceVolumePrototype)
+	err = core.UnmarshalPrimitive(m, "id", &obj.ID)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
+		return
+	}
+	delete(m, "id")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
+	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
 
@@ -3968,6 +5376,59 @@ type StatusResponse struct {
 
 	// Name of the certificate.
 	Name *string `json:"name,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of StatusResponse
+func (o *StatusResponse) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of StatusResponse
+func (o *StatusResponse) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of StatusResponse
+func (o *StatusResponse) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of StatusResponse
+func (o *StatusResponse) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of StatusResponse
+func (o *StatusResponse) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.ExpirationDate != nil {
+		m["expiration_date"] = o.ExpirationDate
+	}
+	if o.Expired != nil {
+		m["expired"] = o.Expired
+	}
+	if o.Name != nil {
+		m["name"] = o.Name
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalStatusResponse unmarshals an instance of StatusResponse from the specified map of raw messages.
@@ -3978,16 +5439,28 @@ func UnmarshalStatusResponse(m map[string]json.RawMessage, result interface{}) (
 		err = core.SDKErrorf(err, "", "expiration_date-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "expiration_date")
 	err = core.UnmarshalPrimitive(m, "expired", &obj.Expired)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "expired-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "expired")
 	err = core.UnmarshalPrimitive(m, "name", &obj.Name)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "name-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "name")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -3996,6 +5469,53 @@ func UnmarshalStatusResponse(m map[string]json.RawMessage, result interface{}) (
 type StorageCredResponse struct {
 	// An array of HMAC Credential access keys.
 	S3Credentials []string `json:"s3_credentials" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of StorageCredResponse
+func (o *StorageCredResponse) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of StorageCredResponse
+func (o *StorageCredResponse) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of StorageCredResponse
+func (o *StorageCredResponse) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of StorageCredResponse
+func (o *StorageCredResponse) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of StorageCredResponse
+func (o *StorageCredResponse) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.S3Credentials != nil {
+		m["s3_credentials"] = o.S3Credentials
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalStorageCredResponse unmarshals an instance of StorageCredResponse from the specified map of raw messages.
@@ -4006,6 +5526,16 @@ func UnmarshalStorageCredResponse(m map[string]json.RawMessage, result interface
 		err = core.SDKErrorf(err, "", "s3_credentials-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "s3_credentials")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4163,7 +5693,7 @@ type Volume struct {
 	// The status of the volume resource. The enumerated values for this property will expand in the future. When
 	// processing this property, check for and log unknown values. Optionally halt processing and surface the error, or
 	// bypass the resource on which the unexpected property value was encountered.
-	Status *string `json:"status" validate:"required"`
+	Status *VolumeStatus `json:"status" validate:"required"`
 
 	// The reasons for the current status (if any).
 	StatusReasons []VolumeStatusReason `json:"status_reasons" validate:"required"`
@@ -4173,6 +5703,114 @@ type Volume struct {
 
 	// The unique identifier for this resource.
 	VolumeGroup *string `json:"volume_group,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// VolumeStatus : The status of a volume. The enumerated values will expand in the future: check for and log unknown
+// values with IsKnown, then optionally halt processing and surface the error, or bypass the resource.
+type VolumeStatus string
+
+// Constants associated with the Volume.Status property.
+// The status of the volume resource.
+const (
+	VolumeStatusAvailableConst       VolumeStatus = "available"
+	VolumeStatusPendingConst         VolumeStatus = "pending"
+	VolumeStatusPendingDeletionConst VolumeStatus = "pending_deletion"
+	VolumeStatusUpdatingConst        VolumeStatus = "updating"
+)
+
+// IsKnown returns true if the status is one of the values defined by this version of the SDK.
+func (status VolumeStatus) IsKnown() bool {
+	switch status {
+	case VolumeStatusAvailableConst, VolumeStatusPendingConst, VolumeStatusPendingDeletionConst, VolumeStatusUpdatingConst:
+		return true
+	}
+	return false
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of Volume
+func (o *Volume) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of Volume
+func (o *Volume) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of Volume
+func (o *Volume) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of Volume
+func (o *Volume) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of Volume
+func (o *Volume) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.ID != nil {
+		m["id"] = o.ID
+	}
+	if o.Href != nil {
+		m["href"] = o.Href
+	}
+	if o.Name != nil {
+		m["name"] = o.Name
+	}
+	if o.CreatedAt != nil {
+		m["created_at"] = o.CreatedAt
+	}
+	if o.ResourceType != nil {
+		m["resource_type"] = o.ResourceType
+	}
+	if o.Capacity != nil {
+		m["capacity"] = o.Capacity
+	}
+	if o.SnapshotCount != nil {
+		m["snapshot_count"] = o.SnapshotCount
+	}
+	if o.Bandwidth != nil {
+		m["bandwidth"] = o.Bandwidth
+	}
+	if o.Iops != nil {
+		m["iops"] = o.Iops
+	}
+	if o.VolumeMappings != nil {
+		m["volume_mappings"] = o.VolumeMappings
+	}
+	if o.Status != nil {
+		m["status"] = o.Status
+	}
+	if o.StatusReasons != nil {
+		m["status_reasons"] = o.StatusReasons
+	}
+	if o.SourceSnapshot != nil {
+		m["source_snapshot"] = o.SourceSnapshot
+	}
+	if o.VolumeGroup != nil {
+		m["volume_group"] = o.VolumeGroup
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalVolume unmarshals an instance of Volume from the specified map of raw messages.
@@ -4183,71 +5821,94 @@ func UnmarshalVolume(m map[string]json.RawMessage, result interface{}) (err erro
 		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "id")
 	err = core.UnmarshalPrimitive(m, "href", &obj.Href)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "href-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "href")
 	err = core.UnmarshalPrimitive(m, "name", &obj.Name)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "name-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "name")
 	err = core.UnmarshalPrimitive(m, "created_at", &obj.CreatedAt)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "created_at-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "created_at")
 	err = core.UnmarshalPrimitive(m, "resource_type", &obj.ResourceType)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "resource_type-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "resource_type")
 	err = core.UnmarshalPrimitive(m, "capacity", &obj.Capacity)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "capacity-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "capacity")
 	err = core.UnmarshalPrimitive(m, "snapshot_count", &obj.SnapshotCount)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "snapshot_count-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "snapshot_count")
 	err = core.UnmarshalPrimitive(m, "bandwidth", &obj.Bandwidth)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "bandwidth-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "bandwidth")
 	err = core.UnmarshalPrimitive(m, "iops", &obj.Iops)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "iops-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "iops")
 	err = core.UnmarshalModel(m, "volume_mappings", &obj.VolumeMappings, UnmarshalVolumeMapping)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "volume_mappings-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "volume_mappings")
 	err = core.UnmarshalPrimitive(m, "status", &obj.Status)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "status-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "status")
 	err = core.UnmarshalModel(m, "status_reasons", &obj.StatusReasons, UnmarshalVolumeStatusReason)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "status_reasons-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "status_reasons")
 	err = core.UnmarshalModel(m, "source_snapshot", &obj.SourceSnapshot, UnmarshalSourceSnapshot)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "source_snapshot-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "source_snapshot")
 	err = core.UnmarshalPrimitive(m, "volume_group", &obj.VolumeGroup)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "volume_group-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "volume_group")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4270,6 +5931,65 @@ type VolumeCollection struct {
 	//     Example:
 	//       132.
 	TotalCount *int64 `json:"total_count" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of VolumeCollection
+func (o *VolumeCollection) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of VolumeCollection
+func (o *VolumeCollection) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of VolumeCollection
+func (o *VolumeCollection) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of VolumeCollection
+func (o *VolumeCollection) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of VolumeCollection
+func (o *VolumeCollection) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Volumes != nil {
+		m["volumes"] = o.Volumes
+	}
+	if o.First != nil {
+		m["first"] = o.First
+	}
+	if o.Limit != nil {
+		m["limit"] = o.Limit
+	}
+	if o.Next != nil {
+		m["next"] = o.Next
+	}
+	if o.TotalCount != nil {
+		m["total_count"] = o.TotalCount
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalVolumeCollection unmarshals an instance of VolumeCollection from the specified map of raw messages.
@@ -4280,26 +6000,40 @@ func UnmarshalVolumeCollection(m map[string]json.RawMessage, result interface{})
 		err = core.SDKErrorf(err, "", "volumes-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "volumes")
 	err = core.UnmarshalModel(m, "first", &obj.First, UnmarshalPageLink)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "first-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "first")
 	err = core.UnmarshalPrimitive(m, "limit", &obj.Limit)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "limit-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "limit")
 	err = core.UnmarshalModel(m, "next", &obj.Next, UnmarshalPageLink)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "next-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "next")
 	err = core.UnmarshalPrimitive(m, "total_count", &obj.TotalCount)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "total_count-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "total_count")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4323,6 +6057,9 @@ func (resp *VolumeCollection) GetNextStart() (*string, error) {
 type VolumeIdentity struct {
 	// The unique identifier for this resource.
 	ID *string `json:"id" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewVolumeIdentity : Instantiate VolumeIdentity (Generic Model Constructor)
@@ -4337,6 +6074,50 @@ func (*SdsaasV2) NewVolumeIdentity(id string) (_model *VolumeIdentity, err error
 	return
 }
 
+// SetProperty allows the user to set an arbitrary property on an instance of VolumeIdentity
+func (o *VolumeIdentity) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of VolumeIdentity
+func (o *VolumeIdentity) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of VolumeIdentity
+func (o *VolumeIdentity) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of VolumeIdentity
+func (o *VolumeIdentity) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of VolumeIdentity
+func (o *VolumeIdentity) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.ID != nil {
+		m["id"] = o.ID
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
+}
+
 // UnmarshalVolumeIdentity unmarshals an instance of VolumeIdentity from the specified map of raw messages.
 func UnmarshalVolumeIdentity(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(VolumeIdentity)
@@ -4345,6 +6126,16 @@ func UnmarshalVolumeIdentity(m map[string]json.RawMessage, result interface{}) (
 		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "id")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4354,7 +6145,7 @@ type VolumeMapping struct {
 	// The status of the volume mapping. The enumerated values for this property will expand in the future. When processing
 	// this property, check for and log unknown values. Optionally halt processing and surface the error, or bypass the
 	// resource on which the unexpected property value was encountered.
-	Status *string `json:"status" validate:"required"`
+	Status *VolumeMappingStatus `json:"status" validate:"required"`
 
 	// The URL for this resource.
 	Href *string `json:"href" validate:"required"`
@@ -4376,6 +6167,96 @@ type VolumeMapping struct {
 
 	// List of NVMe gateways.
 	Gateways []Gateway `json:"gateways" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// VolumeMappingStatus : The status of a volume mapping. The enumerated values will expand in the future: check for and log unknown
+// values with IsKnown, then optionally halt processing and surface the error, or bypass the resource.
+type VolumeMappingStatus string
+
+// Constants associated with the VolumeMapping.Status property.
+// The status of the volume mapping.
+const (
+	VolumeMappingStatusMappedConst           VolumeMappingStatus = "mapped"
+	VolumeMappingStatusMappingFailedConst    VolumeMappingStatus = "mapping_failed"
+	VolumeMappingStatusPendingConst          VolumeMappingStatus = "pending"
+	VolumeMappingStatusPendingUnmappingConst VolumeMappingStatus = "pending_unmapping"
+)
+
+// IsKnown returns true if the status is one of the values defined by this version of the SDK.
+func (status VolumeMappingStatus) IsKnown() bool {
+	switch status {
+	case VolumeMappingStatusMappedConst, VolumeMappingStatusMappingFailedConst, VolumeMappingStatusPendingConst, VolumeMappingStatusPendingUnmappingConst:
+		return true
+	}
+	return false
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of VolumeMapping
+func (o *VolumeMapping) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of VolumeMapping
+func (o *VolumeMapping) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of VolumeMapping
+func (o *VolumeMapping) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of VolumeMapping
+func (o *VolumeMapping) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of VolumeMapping
+func (o *VolumeMapping) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Status != nil {
+		m["status"] = o.Status
+	}
+	if o.Href != nil {
+		m["href"] = o.Href
+	}
+	if o.ID != nil {
+		m["id"] = o.ID
+	}
+	if o.Volume != nil {
+		m["volume"] = o.Volume
+	}
+	if o.Host != nil {
+		m["host"] = o.Host
+	}
+	if o.SubsystemNqn != nil {
+		m["subsystem_nqn"] = o.SubsystemNqn
+	}
+	if o.Namespace != nil {
+		m["namespace"] = o.Namespace
+	}
+	if o.Gateways != nil {
+		m["gateways"] = o.Gateways
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalVolumeMapping unmarshals an instance of VolumeMapping from the specified map of raw messages.
@@ -4386,41 +6267,58 @@ func UnmarshalVolumeMapping(m map[string]json.RawMessage, result interface{}) (e
 		err = core.SDKErrorf(err, "", "status-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "status")
 	err = core.UnmarshalPrimitive(m, "href", &obj.Href)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "href-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "href")
 	err = core.UnmarshalPrimitive(m, "id", &obj.ID)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "id")
 	err = core.UnmarshalModel(m, "volume", &obj.Volume, UnmarshalVolumeReference)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "volume-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "volume")
 	err = core.UnmarshalModel(m, "host", &obj.Host, UnmarshalHostReference)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "host-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "host")
 	err = core.UnmarshalPrimitive(m, "subsystem_nqn", &obj.SubsystemNqn)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "subsystem_nqn-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "subsystem_nqn")
 	err = core.UnmarshalModel(m, "namespace", &obj.Namespace, UnmarshalNamespace)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "namespace-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "namespace")
 	err = core.UnmarshalModel(m, "gateways", &obj.Gateways, UnmarshalGateway)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "gateways-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "gateways")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4443,6 +6341,65 @@ type VolumeMappingCollection struct {
 	//     Example:
 	//       132.
 	TotalCount *int64 `json:"total_count" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of VolumeMappingCollection
+func (o *VolumeMappingCollection) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of VolumeMappingCollection
+func (o *VolumeMappingCollection) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of VolumeMappingCollection
+func (o *VolumeMappingCollection) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of VolumeMappingCollection
+func (o *VolumeMappingCollection) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of VolumeMappingCollection
+func (o *VolumeMappingCollection) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.First != nil {
+		m["first"] = o.First
+	}
+	if o.VolumeMappings != nil {
+		m["volume_mappings"] = o.VolumeMappings
+	}
+	if o.Limit != nil {
+		m["limit"] = o.Limit
+	}
+	if o.Next != nil {
+		m["next"] = o.Next
+	}
+	if o.TotalCount != nil {
+		m["total_count"] = o.TotalCount
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalVolumeMappingCollection unmarshals an instance of VolumeMappingCollection from the specified map of raw messages.
@@ -4453,26 +6410,40 @@ func UnmarshalVolumeMappingCollection(m map[string]json.RawMessage, result inter
 		err = core.SDKErrorf(err, "", "first-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "first")
 	err = core.UnmarshalModel(m, "volume_mappings", &obj.VolumeMappings, UnmarshalVolumeMapping)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "volume_mappings-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "volume_mappings")
 	err = core.UnmarshalPrimitive(m, "limit", &obj.Limit)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "limit-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "limit")
 	err = core.UnmarshalModel(m, "next", &obj.Next, UnmarshalPageLink)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "next-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "next")
 	err = core.UnmarshalPrimitive(m, "total_count", &obj.TotalCount)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "total_count-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "total_count")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4496,6 +6467,9 @@ func (resp *VolumeMappingCollection) GetNextStart() (*string, error) {
 type VolumeMappingPrototype struct {
 	// Volume identifier.
 	Volume *VolumeIdentity `json:"volume" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
 }
 
 // NewVolumeMappingPrototype : Instantiate VolumeMappingPrototype (Generic Model Constructor)
@@ -4510,6 +6484,50 @@ func (*SdsaasV2) NewVolumeMappingPrototype(volume *VolumeIdentity) (_model *Volu
 	return
 }
 
+// SetProperty allows the user to set an arbitrary property on an instance of VolumeMappingPrototype
+func (o *VolumeMappingPrototype) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of VolumeMappingPrototype
+func (o *VolumeMappingPrototype) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of VolumeMappingPrototype
+func (o *VolumeMappingPrototype) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of VolumeMappingPrototype
+func (o *VolumeMappingPrototype) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of VolumeMappingPrototype
+func (o *VolumeMappingPrototype) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Volume != nil {
+		m["volume"] = o.Volume
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
+}
+
 // UnmarshalVolumeMappingPrototype unmarshals an instance of VolumeMappingPrototype from the specified map of raw messages.
 func UnmarshalVolumeMappingPrototype(m map[string]json.RawMessage, result interface{}) (err error) {
 	obj := new(VolumeMappingPrototype)
@@ -4518,6 +6536,16 @@ func UnmarshalVolumeMappingPrototype(m map[string]json.RawMessage, result interf
 		err = core.SDKErrorf(err, "", "volume-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "volume")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4527,7 +6555,7 @@ type VolumeMappingReference struct {
 	// The status of the volume mapping. The enumerated values for this property will expand in the future. When processing
 	// this property, check for and log unknown values. Optionally halt processing and surface the error, or bypass the
 	// resource on which the unexpected property value was encountered.
-	Status *string `json:"status" validate:"required"`
+	Status *VolumeMappingStatus `json:"status" validate:"required"`
 
 	// The URL for this resource.
 	Href *string `json:"href" validate:"required"`
@@ -4540,6 +6568,65 @@ type VolumeMappingReference struct {
 
 	// Host mapping schema.
 	Host *HostReference `json:"host" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of VolumeMappingReference
+func (o *VolumeMappingReference) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of VolumeMappingReference
+func (o *VolumeMappingReference) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of VolumeMappingReference
+func (o *VolumeMappingReference) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of VolumeMappingReference
+func (o *VolumeMappingReference) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of VolumeMappingReference
+func (o *VolumeMappingReference) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Status != nil {
+		m["status"] = o.Status
+	}
+	if o.Href != nil {
+		m["href"] = o.Href
+	}
+	if o.ID != nil {
+		m["id"] = o.ID
+	}
+	if o.Volume != nil {
+		m["volume"] = o.Volume
+	}
+	if o.Host != nil {
+		m["host"] = o.Host
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalVolumeMappingReference unmarshals an instance of VolumeMappingReference from the specified map of raw messages.
@@ -4550,26 +6637,40 @@ func UnmarshalVolumeMappingReference(m map[string]json.RawMessage, result interf
 		err = core.SDKErrorf(err, "", "status-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "status")
 	err = core.UnmarshalPrimitive(m, "href", &obj.Href)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "href-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "href")
 	err = core.UnmarshalPrimitive(m, "id", &obj.ID)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "id")
 	err = core.UnmarshalModel(m, "volume", &obj.Volume, UnmarshalVolumeReference)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "volume-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "volume")
 	err = core.UnmarshalModel(m, "host", &obj.Host, UnmarshalHostReference)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "host-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "host")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4582,6 +6683,56 @@ type VolumePatch struct {
 
 	// The name for this volume. The name must not be used by another volume.
 	Name *string `json:"name,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of VolumePatch
+func (o *VolumePatch) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of VolumePatch
+func (o *VolumePatch) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of VolumePatch
+func (o *VolumePatch) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of VolumePatch
+func (o *VolumePatch) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of VolumePatch
+func (o *VolumePatch) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Capacity != nil {
+		m["capacity"] = o.Capacity
+	}
+	if o.Name != nil {
+		m["name"] = o.Name
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalVolumePatch unmarshals an instance of VolumePatch from the specified map of raw messages.
@@ -4592,11 +6743,22 @@ func UnmarshalVolumePatch(m map[string]json.RawMessage, result interface{}) (err
 		err = core.SDKErrorf(err, "", "capacity-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "capacity")
 	err = core.UnmarshalPrimitive(m, "name", &obj.Name)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "name-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "name")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4604,6 +6766,9 @@ func UnmarshalVolumePatch(m map[string]json.RawMessage, result interface{}) (err
 // AsPatch returns a generic map representation of the VolumePatch
 func (volumePatch *VolumePatch) AsPatch() (_patch map[string]interface{}, err error) {
 	_patch = map[string]interface{}{}
+	for k, v := range volumePatch.additionalProperties {
+		_patch[k] = v
+	}
 	if !core.IsNil(volumePatch.Capacity) {
 		_patch["capacity"] = volumePatch.Capacity
 	}
@@ -4621,6 +6786,56 @@ type VolumeReference struct {
 
 	// The unique name for this resource.
 	Name *string `json:"name" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of VolumeReference
+func (o *VolumeReference) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of VolumeReference
+func (o *VolumeReference) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of VolumeReference
+func (o *VolumeReference) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of VolumeReference
+func (o *VolumeReference) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of VolumeReference
+func (o *VolumeReference) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.ID != nil {
+		m["id"] = o.ID
+	}
+	if o.Name != nil {
+		m["name"] = o.Name
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalVolumeReference unmarshals an instance of VolumeReference from the specified map of raw messages.
@@ -4631,11 +6846,22 @@ func UnmarshalVolumeReference(m map[string]json.RawMessage, result interface{})
 		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "id")
 	err = core.UnmarshalPrimitive(m, "name", &obj.Name)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "name-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "name")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4650,6 +6876,59 @@ type VolumeStatusReason struct {
 
 	// Link to documentation about this status reason.
 	MoreInfo *string `json:"more_info,omitempty"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of VolumeStatusReason
+func (o *VolumeStatusReason) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of VolumeStatusReason
+func (o *VolumeStatusReason) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of VolumeStatusReason
+func (o *VolumeStatusReason) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of VolumeStatusReason
+func (o *VolumeStatusReason) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of VolumeStatusReason
+func (o *VolumeStatusReason) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.Code != nil {
+		m["code"] = o.Code
+	}
+	if o.Message != nil {
+		m["message"] = o.Message
+	}
+	if o.MoreInfo != nil {
+		m["more_info"] = o.MoreInfo
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalVolumeStatusReason unmarshals an instance of VolumeStatusReason from the specified map of raw messages.
@@ -4660,16 +6939,28 @@ func UnmarshalVolumeStatusReason(m map[string]json.RawMessage, result interface{
 		err = core.SDKErrorf(err, "", "code-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "code")
 	err = core.UnmarshalPrimitive(m, "message", &obj.Message)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "message-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "message")
 	err = core.UnmarshalPrimitive(m, "more_info", &obj.MoreInfo)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "more_info-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "more_info")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -4707,10 +6998,84 @@ type VolumeSummary struct {
 	// The status of the volume resource. The enumerated values for this property will expand in the future. When
 	// processing this property, check for and log unknown values. Optionally halt processing and surface the error, or
 	// bypass the resource on which the unexpected property value was encountered.
-	Status *string `json:"status" validate:"required"`
+	Status *VolumeStatus `json:"status" validate:"required"`
 
 	// The reasons for the current status (if any).
 	StatusReasons []VolumeStatusReason `json:"status_reasons" validate:"required"`
+
+	// Allows users to set arbitrary properties
+	additionalProperties map[string]interface{}
+}
+
+// SetProperty allows the user to set an arbitrary property on an instance of VolumeSummary
+func (o *VolumeSummary) SetProperty(key string, value interface{}) {
+	if o.additionalProperties == nil {
+		o.additionalProperties = make(map[string]interface{})
+	}
+	o.additionalProperties[key] = value
+}
+
+// SetProperties allows the user to set a map of arbitrary properties on an instance of VolumeSummary
+func (o *VolumeSummary) SetProperties(m map[string]interface{}) {
+	o.additionalProperties = make(map[string]interface{})
+	for k, v := range m {
+		o.additionalProperties[k] = v
+	}
+}
+
+// GetProperty allows the user to retrieve an arbitrary property from an instance of VolumeSummary
+func (o *VolumeSummary) GetProperty(key string) interface{} {
+	return o.additionalProperties[key]
+}
+
+// GetProperties allows the user to retrieve the map of arbitrary properties from an instance of VolumeSummary
+func (o *VolumeSummary) GetProperties() map[string]interface{} {
+	return o.additionalProperties
+}
+
+// MarshalJSON performs custom serialization for instances of VolumeSummary
+func (o *VolumeSummary) MarshalJSON() (buffer []byte, err error) {
+	m := make(map[string]interface{})
+	if len(o.additionalProperties) > 0 {
+		for k, v := range o.additionalProperties {
+			m[k] = v
+		}
+	}
+	if o.ID != nil {
+		m["id"] = o.ID
+	}
+	if o.Href != nil {
+		m["href"] = o.Href
+	}
+	if o.Name != nil {
+		m["name"] = o.Name
+	}
+	if o.CreatedAt != nil {
+		m["created_at"] = o.CreatedAt
+	}
+	if o.ResourceType != nil {
+		m["resource_type"] = o.ResourceType
+	}
+	if o.Capacity != nil {
+		m["capacity"] = o.Capacity
+	}
+	if o.Bandwidth != nil {
+		m["bandwidth"] = o.Bandwidth
+	}
+	if o.Iops != nil {
+		m["iops"] = o.Iops
+	}
+	if o.Status != nil {
+		m["status"] = o.Status
+	}
+	if o.StatusReasons != nil {
+		m["status_reasons"] = o.StatusReasons
+	}
+	buffer, err = json.Marshal(m)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "model-marshal", common.GetComponentInfo())
+	}
+	return
 }
 
 // UnmarshalVolumeSummary unmarshals an instance of VolumeSummary from the specified map of raw messages.
@@ -4721,51 +7086,70 @@ func UnmarshalVolumeSummary(m map[string]json.RawMessage, result interface{}) (e
 		err = core.SDKErrorf(err, "", "id-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "id")
 	err = core.UnmarshalPrimitive(m, "href", &obj.Href)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "href-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "href")
 	err = core.UnmarshalPrimitive(m, "name", &obj.Name)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "name-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "name")
 	err = core.UnmarshalPrimitive(m, "created_at", &obj.CreatedAt)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "created_at-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "created_at")
 	err = core.UnmarshalPrimitive(m, "resource_type", &obj.ResourceType)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "resource_type-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "resource_type")
 	err = core.UnmarshalPrimitive(m, "capacity", &obj.Capacity)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "capacity-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "capacity")
 	err = core.UnmarshalPrimitive(m, "bandwidth", &obj.Bandwidth)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "bandwidth-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "bandwidth")
 	err = core.UnmarshalPrimitive(m, "iops", &obj.Iops)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "iops-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "iops")
 	err = core.UnmarshalPrimitive(m, "status", &obj.Status)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "status-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "status")
 	err = core.UnmarshalModel(m, "status_reasons", &obj.StatusReasons, UnmarshalVolumeStatusReason)
 	if err != nil {
 		err = core.SDKErrorf(err, "", "status_reasons-error", common.GetComponentInfo())
 		return
 	}
+	delete(m, "status_reasons")
+	for k := range m {
+		var v interface{}
+		e := core.UnmarshalPrimitive(m, k, &v)
+		if e != nil {
+			err = core.SDKErrorf(e, "", "additional-properties-error", common.GetComponentInfo())
+			return
+		}
+		obj.SetProperty(k, v)
+	}
 	reflect.ValueOf(result).Elem().Set(reflect.ValueOf(obj))
 	return
 }
@@ -0,0 +1,1193 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package sdsaasv1adapter provides SdsaasV1Adapter, which exposes the method set and models of the sdsaasv1
+// package on top of an sdsaasv2 client, so that code written against sdsaasv1 can move to the v2 API before it
+// is rewritten. Code that depends on SdsaasV1API accepts both the sdsaasv1 service and the adapter.
+package sdsaasv1adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv1"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+)
+
+// ErrUnsupportedOperation is matched by the errors of the requests that have no sdsaasv2 equivalent.
+var ErrUnsupportedOperation = errors.New("the operation has no sdsaasv2 equivalent")
+
+// v1 provides the options and model constructors of sdsaasv1, which do not use their service.
+var v1 *sdsaasv1.SdsaasV1
+
+// SdsaasV1API : The operations of the sdsaasv1 service, implemented by both sdsaasv1.SdsaasV1 and SdsaasV1Adapter.
+type SdsaasV1API interface {
+	Volumes(volumesOptions *sdsaasv1.VolumesOptions) (result *sdsaasv1.VolumeCollection, response *core.DetailedResponse, err error)
+	VolumesWithContext(ctx context.Context, volumesOptions *sdsaasv1.VolumesOptions) (result *sdsaasv1.VolumeCollection, response *core.DetailedResponse, err error)
+	VolumeCreate(volumeCreateOptions *sdsaasv1.VolumeCreateOptions) (result *sdsaasv1.Volume, response *core.DetailedResponse, err error)
+	VolumeCreateWithContext(ctx context.Context, volumeCreateOptions *sdsaasv1.VolumeCreateOptions) (result *sdsaasv1.Volume, response *core.DetailedResponse, err error)
+	Volume(volumeOptions *sdsaasv1.VolumeOptions) (result *sdsaasv1.Volume, response *core.DetailedResponse, err error)
+	VolumeWithContext(ctx context.Context, volumeOptions *sdsaasv1.VolumeOptions) (result *sdsaasv1.Volume, response *core.DetailedResponse, err error)
+	VolumeDelete(volumeDeleteOptions *sdsaasv1.VolumeDeleteOptions) (response *core.DetailedResponse, err error)
+	VolumeDeleteWithContext(ctx context.Context, volumeDeleteOptions *sdsaasv1.VolumeDeleteOptions) (response *core.DetailedResponse, err error)
+	VolumeUpdate(volumeUpdateOptions *sdsaasv1.VolumeUpdateOptions) (result *sdsaasv1.Volume, response *core.DetailedResponse, err error)
+	VolumeUpdateWithContext(ctx context.Context, volumeUpdateOptions *sdsaasv1.VolumeUpdateOptions) (result *sdsaasv1.Volume, response *core.DetailedResponse, err error)
+	VolumeSnapshots(volumeSnapshotsOptions *sdsaasv1.VolumeSnapshotsOptions) (result *sdsaasv1.SnapshotCollection, response *core.DetailedResponse, err error)
+	VolumeSnapshotsWithContext(ctx context.Context, volumeSnapshotsOptions *sdsaasv1.VolumeSnapshotsOptions) (result *sdsaasv1.SnapshotCollection, response *core.DetailedResponse, err error)
+	VolumeSnapshotCreate(volumeSnapshotCreateOptions *sdsaasv1.VolumeSnapshotCreateOptions) (result *sdsaasv1.Snapshot, response *core.DetailedResponse, err error)
+	VolumeSnapshotCreateWithContext(ctx context.Context, volumeSnapshotCreateOptions *sdsaasv1.VolumeSnapshotCreateOptions) (result *sdsaasv1.Snapshot, response *core.DetailedResponse, err error)
+	VolumeSnapshotsDelete(volumeSnapshotsDeleteOptions *sdsaasv1.VolumeSnapshotsDeleteOptions) (response *core.DetailedResponse, err error)
+	VolumeSnapshotsDeleteWithContext(ctx context.Context, volumeSnapshotsDeleteOptions *sdsaasv1.VolumeSnapshotsDeleteOptions) (response *core.DetailedResponse, err error)
+	VolumeSnapshot(volumeSnapshotOptions *sdsaasv1.VolumeSnapshotOptions) (result *sdsaasv1.Snapshot, response *core.DetailedResponse, err error)
+	VolumeSnapshotWithContext(ctx context.Context, volumeSnapshotOptions *sdsaasv1.VolumeSnapshotOptions) (result *sdsaasv1.Snapshot, response *core.DetailedResponse, err error)
+	VolumeSnapshotUpdate(volumeSnapshotUpdateOptions *sdsaasv1.VolumeSnapshotUpdateOptions) (result *sdsaasv1.Snapshot, response *core.DetailedResponse, err error)
+	VolumeSnapshotUpdateWithContext(ctx context.Context, volumeSnapshotUpdateOptions *sdsaasv1.VolumeSnapshotUpdateOptions) (result *sdsaasv1.Snapshot, response *core.DetailedResponse, err error)
+	VolumeSnapshotDelete(volumeSnapshotDeleteOptions *sdsaasv1.VolumeSnapshotDeleteOptions) (response *core.DetailedResponse, err error)
+	VolumeSnapshotDeleteWithContext(ctx context.Context, volumeSnapshotDeleteOptions *sdsaasv1.VolumeSnapshotDeleteOptions) (response *core.DetailedResponse, err error)
+	Creds(credsOptions *sdsaasv1.CredsOptions) (result *sdsaasv1.CredentialsFound, response *core.DetailedResponse, err error)
+	CredsWithContext(ctx context.Context, credsOptions *sdsaasv1.CredsOptions) (result *sdsaasv1.CredentialsFound, response *core.DetailedResponse, err error)
+	CredCreate(credCreateOptions *sdsaasv1.CredCreateOptions) (result *sdsaasv1.CredentialsUpdated, response *core.DetailedResponse, err error)
+	CredCreateWithContext(ctx context.Context, credCreateOptions *sdsaasv1.CredCreateOptions) (result *sdsaasv1.CredentialsUpdated, response *core.DetailedResponse, err error)
+	CredDelete(credDeleteOptions *sdsaasv1.CredDeleteOptions) (response *core.DetailedResponse, err error)
+	CredDeleteWithContext(ctx context.Context, credDeleteOptions *sdsaasv1.CredDeleteOptions) (response *core.DetailedResponse, err error)
+	CertTypes(certTypesOptions *sdsaasv1.CertTypesOptions) (result *sdsaasv1.CertificateList, response *core.DetailedResponse, err error)
+	CertTypesWithContext(ctx context.Context, certTypesOptions *sdsaasv1.CertTypesOptions) (result *sdsaasv1.CertificateList, response *core.DetailedResponse, err error)
+	Cert(certOptions *sdsaasv1.CertOptions) (result *sdsaasv1.CertificateFound, response *core.DetailedResponse, err error)
+	CertWithContext(ctx context.Context, certOptions *sdsaasv1.CertOptions) (result *sdsaasv1.CertificateFound, response *core.DetailedResponse, err error)
+	CertDelete(certDeleteOptions *sdsaasv1.CertDeleteOptions) (response *core.DetailedResponse, err error)
+	CertDeleteWithContext(ctx context.Context, certDeleteOptions *sdsaasv1.CertDeleteOptions) (response *core.DetailedResponse, err error)
+	CertCreate(certCreateOptions *sdsaasv1.CertCreateOptions) (result *sdsaasv1.CertificateUpdated, response *core.DetailedResponse, err error)
+	CertCreateWithContext(ctx context.Context, certCreateOptions *sdsaasv1.CertCreateOptions) (result *sdsaasv1.CertificateUpdated, response *core.DetailedResponse, err error)
+	CertUpdate(certUpdateOptions *sdsaasv1.CertUpdateOptions) (result *sdsaasv1.CertificateUpdated, response *core.DetailedResponse, err error)
+	CertUpdateWithContext(ctx context.Context, certUpdateOptions *sdsaasv1.CertUpdateOptions) (result *sdsaasv1.CertificateUpdated, response *core.DetailedResponse, err error)
+	Hosts(hostsOptions *sdsaasv1.HostsOptions) (result *sdsaasv1.HostCollection, response *core.DetailedResponse, err error)
+	HostsWithContext(ctx context.Context, hostsOptions *sdsaasv1.HostsOptions) (result *sdsaasv1.HostCollection, response *core.DetailedResponse, err error)
+	HostCreate(hostCreateOptions *sdsaasv1.HostCreateOptions) (result *sdsaasv1.Host, response *core.DetailedResponse, err error)
+	HostCreateWithContext(ctx context.Context, hostCreateOptions *sdsaasv1.HostCreateOptions) (result *sdsaasv1.Host, response *core.DetailedResponse, err error)
+	Host(hostOptions *sdsaasv1.HostOptions) (result *sdsaasv1.Host, response *core.DetailedResponse, err error)
+	HostWithContext(ctx context.Context, hostOptions *sdsaasv1.HostOptions) (result *sdsaasv1.Host, response *core.DetailedResponse, err error)
+	HostUpdate(hostUpdateOptions *sdsaasv1.HostUpdateOptions) (result *sdsaasv1.Host, response *core.DetailedResponse, err error)
+	HostUpdateWithContext(ctx context.Context, hostUpdateOptions *sdsaasv1.HostUpdateOptions) (result *sdsaasv1.Host, response *core.DetailedResponse, err error)
+	HostDelete(hostDeleteOptions *sdsaasv1.HostDeleteOptions) (response *core.DetailedResponse, err error)
+	HostDeleteWithContext(ctx context.Context, hostDeleteOptions *sdsaasv1.HostDeleteOptions) (response *core.DetailedResponse, err error)
+	HostMappings(hostMappingsOptions *sdsaasv1.HostMappingsOptions) (result *sdsaasv1.VolumeMappingCollection, response *core.DetailedResponse, err error)
+	HostMappingsWithContext(ctx context.Context, hostMappingsOptions *sdsaasv1.HostMappingsOptions) (result *sdsaasv1.VolumeMappingCollection, response *core.DetailedResponse, err error)
+	HostMappingCreate(hostMappingCreateOptions *sdsaasv1.HostMappingCreateOptions) (result *sdsaasv1.VolumeMapping, response *core.DetailedResponse, err error)
+	HostMappingCreateWithContext(ctx context.Context, hostMappingCreateOptions *sdsaasv1.HostMappingCreateOptions) (result *sdsaasv1.VolumeMapping, response *core.DetailedResponse, err error)
+	HostMappingDeleteAll(hostMappingDeleteAllOptions *sdsaasv1.HostMappingDeleteAllOptions) (response *core.DetailedResponse, err error)
+	HostMappingDeleteAllWithContext(ctx context.Context, hostMappingDeleteAllOptions *sdsaasv1.HostMappingDeleteAllOptions) (response *core.DetailedResponse, err error)
+	HostMapping(hostMappingOptions *sdsaasv1.HostMappingOptions) (result *sdsaasv1.VolumeMapping, response *core.DetailedResponse, err error)
+	HostMappingWithContext(ctx context.Context, hostMappingOptions *sdsaasv1.HostMappingOptions) (result *sdsaasv1.VolumeMapping, response *core.DetailedResponse, err error)
+	HostMappingDelete(hostMappingDeleteOptions *sdsaasv1.HostMappingDeleteOptions) (response *core.DetailedResponse, err error)
+	HostMappingDeleteWithContext(ctx context.Context, hostMappingDeleteOptions *sdsaasv1.HostMappingDeleteOptions) (response *core.DetailedResponse, err error)
+}
+
+var (
+	_ SdsaasV1API = (*sdsaasv1.SdsaasV1)(nil)
+	_ SdsaasV1API = (*SdsaasV1Adapter)(nil)
+)
+
+// SdsaasV1Adapter : The operations, options and models of sdsaasv1, sent with an sdsaasv2 client. The results of the
+// sdsaasv2 operations are translated to the sdsaasv1 models, which are also set as the Result of the responses. A
+// request that has no sdsaasv2 equivalent fails with an error that matches ErrUnsupportedOperation.
+type SdsaasV1Adapter struct {
+	client *sdsaasv2.SdsaasV2
+}
+
+// NewSdsaasV1Adapter : constructs an SdsaasV1Adapter that sends its requests with a client.
+func NewSdsaasV1Adapter(client *sdsaasv2.SdsaasV2) (adapter *SdsaasV1Adapter, err error) {
+	err = core.ValidateNotNil(client, "client cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	adapter = &SdsaasV1Adapter{client: client}
+	return
+}
+
+// GetClient returns the sdsaasv2 client of the adapter.
+func (adapter *SdsaasV1Adapter) GetClient() *sdsaasv2.SdsaasV2 {
+	return adapter.client
+}
+
+// Clone makes a copy of the adapter, with a clone of its client.
+func (adapter *SdsaasV1Adapter) Clone() *SdsaasV1Adapter {
+	if core.IsNil(adapter) {
+		return nil
+	}
+	return &SdsaasV1Adapter{client: adapter.client.Clone()}
+}
+
+// SetServiceURL sets the service URL
+func (adapter *SdsaasV1Adapter) SetServiceURL(url string) error {
+	return adapter.client.SetServiceURL(url)
+}
+
+// GetServiceURL returns the service URL
+func (adapter *SdsaasV1Adapter) GetServiceURL() string {
+	return adapter.client.GetServiceURL()
+}
+
+// SetDefaultHeaders sets HTTP headers to be sent in every request
+func (adapter *SdsaasV1Adapter) SetDefaultHeaders(headers http.Header) {
+	adapter.client.SetDefaultHeaders(headers)
+}
+
+// SetEnableGzipCompression sets the service's EnableGzipCompression field
+func (adapter *SdsaasV1Adapter) SetEnableGzipCompression(enableGzip bool) {
+	adapter.client.SetEnableGzipCompression(enableGzip)
+}
+
+// GetEnableGzipCompression returns the service's EnableGzipCompression field
+func (adapter *SdsaasV1Adapter) GetEnableGzipCompression() bool {
+	return adapter.client.GetEnableGzipCompression()
+}
+
+// EnableRetries enables automatic retries for requests invoked for this service instance.
+// If either parameter is specified as 0, then a default value is used instead.
+func (adapter *SdsaasV1Adapter) EnableRetries(maxRetries int, maxRetryInterval time.Duration) {
+	adapter.client.EnableRetries(maxRetries, maxRetryInterval)
+}
+
+// DisableRetries disables automatic retries for requests invoked for this service instance.
+func (adapter *SdsaasV1Adapter) DisableRetries() {
+	adapter.client.DisableRetries()
+}
+
+// Volumes : This request lists all volumes in the region
+// It calls sdsaasv2.SdsaasV2.ListVolumes.
+func (adapter *SdsaasV1Adapter) Volumes(volumesOptions *sdsaasv1.VolumesOptions) (result *sdsaasv1.VolumeCollection, response *core.DetailedResponse, err error) {
+	result, response, err = adapter.VolumesWithContext(context.Background(), volumesOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// VolumesWithContext is an alternate form of the Volumes method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) VolumesWithContext(ctx context.Context, volumesOptions *sdsaasv1.VolumesOptions) (result *sdsaasv1.VolumeCollection, response *core.DetailedResponse, err error) {
+	err = validate(volumesOptions, "volumesOptions")
+	if err != nil {
+		return
+	}
+	v2Result, response, err := adapter.client.ListVolumesWithContext(ctx, &sdsaasv2.ListVolumesOptions{
+		Start:   volumesOptions.Start,
+		Limit:   volumesOptions.Limit,
+		Name:    volumesOptions.Name,
+		Headers: volumesOptions.Headers,
+	})
+	result = volumeCollection(v2Result)
+	if result != nil {
+		response.Result = result
+	}
+	return
+}
+
+// VolumeCreate : Create a new volume
+// It calls sdsaasv2.SdsaasV2.CreateVolume.
+func (adapter *SdsaasV1Adapter) VolumeCreate(volumeCreateOptions *sdsaasv1.VolumeCreateOptions) (result *sdsaasv1.Volume, response *core.DetailedResponse, err error) {
+	result, response, err = adapter.VolumeCreateWithContext(context.Background(), volumeCreateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// VolumeCreateWithContext is an alternate form of the VolumeCreate method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) VolumeCreateWithContext(ctx context.Context, volumeCreateOptions *sdsaasv1.VolumeCreateOptions) (result *sdsaasv1.Volume, response *core.DetailedResponse, err error) {
+	err = validate(volumeCreateOptions, "volumeCreateOptions")
+	if err != nil {
+		return
+	}
+	options := &sdsaasv2.CreateVolumeOptions{
+		Capacity: volumeCreateOptions.Capacity,
+		Name:     volumeCreateOptions.Name,
+		Headers:  volumeCreateOptions.Headers,
+	}
+	if volumeCreateOptions.SourceSnapshot != nil {
+		options.SourceSnapshot = &sdsaasv2.SourceSnapshot{ID: volumeCreateOptions.SourceSnapshot.ID}
+	}
+	v2Result, response, err := adapter.client.CreateVolumeWithContext(ctx, options)
+	result = volumeSummary(v2Result)
+	if result != nil {
+		response.Result = result
+	}
+	return
+}
+
+// Volume : Retrieve a volume profile
+// It calls sdsaasv2.SdsaasV2.GetVolume.
+func (adapter *SdsaasV1Adapter) Volume(volumeOptions *sdsaasv1.VolumeOptions) (result *sdsaasv1.Volume, response *core.DetailedResponse, err error) {
+	result, response, err = adapter.VolumeWithContext(context.Background(), volumeOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// VolumeWithContext is an alternate form of the Volume method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) VolumeWithContext(ctx context.Context, volumeOptions *sdsaasv1.VolumeOptions) (result *sdsaasv1.Volume, response *core.DetailedResponse, err error) {
+	err = validate(volumeOptions, "volumeOptions")
+	if err != nil {
+		return
+	}
+	v2Result, response, err := adapter.client.GetVolumeWithContext(ctx, &sdsaasv2.GetVolumeOptions{
+		ID:      volumeOptions.VolumeID,
+		Headers: volumeOptions.Headers,
+	})
+	result = volume(v2Result)
+	if result != nil {
+		response.Result = result
+	}
+	return
+}
+
+// VolumeDelete : Delete a volume
+// It calls sdsaasv2.SdsaasV2.DeleteVolume.
+func (adapter *SdsaasV1Adapter) VolumeDelete(volumeDeleteOptions *sdsaasv1.VolumeDeleteOptions) (response *core.DetailedResponse, err error) {
+	response, err = adapter.VolumeDeleteWithContext(context.Background(), volumeDeleteOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// VolumeDeleteWithContext is an alternate form of the VolumeDelete method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) VolumeDeleteWithContext(ctx context.Context, volumeDeleteOptions *sdsaasv1.VolumeDeleteOptions) (response *core.DetailedResponse, err error) {
+	err = validate(volumeDeleteOptions, "volumeDeleteOptions")
+	if err != nil {
+		return
+	}
+	response, err = adapter.client.DeleteVolumeWithContext(ctx, &sdsaasv2.DeleteVolumeOptions{
+		ID:      volumeDeleteOptions.VolumeID,
+		Headers: volumeDeleteOptions.Headers,
+	})
+	return
+}
+
+// VolumeUpdate : Update a volume
+// It calls sdsaasv2.SdsaasV2.UpdateVolume.
+func (adapter *SdsaasV1Adapter) VolumeUpdate(volumeUpdateOptions *sdsaasv1.VolumeUpdateOptions) (result *sdsaasv1.Volume, response *core.DetailedResponse, err error) {
+	result, response, err = adapter.VolumeUpdateWithContext(context.Background(), volumeUpdateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// VolumeUpdateWithContext is an alternate form of the VolumeUpdate method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) VolumeUpdateWithContext(ctx context.Context, volumeUpdateOptions *sdsaasv1.VolumeUpdateOptions) (result *sdsaasv1.Volume, response *core.DetailedResponse, err error) {
+	err = validate(volumeUpdateOptions, "volumeUpdateOptions")
+	if err != nil {
+		return
+	}
+	v2Result, response, err := adapter.client.UpdateVolumeWithContext(ctx, &sdsaasv2.UpdateVolumeOptions{
+		ID:          volumeUpdateOptions.VolumeID,
+		VolumePatch: patch(volumeUpdateOptions.VolumePatch),
+		Headers:     volumeUpdateOptions.Headers,
+	})
+	result = volume(v2Result)
+	if result != nil {
+		response.Result = result
+	}
+	return
+}
+
+// VolumeSnapshots : List all snapshots
+// It calls sdsaasv2.SdsaasV2.ListSnapshots.
+func (adapter *SdsaasV1Adapter) VolumeSnapshots(volumeSnapshotsOptions *sdsaasv1.VolumeSnapshotsOptions) (result *sdsaasv1.SnapshotCollection, response *core.DetailedResponse, err error) {
+	result, response, err = adapter.VolumeSnapshotsWithContext(context.Background(), volumeSnapshotsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// VolumeSnapshotsWithContext is an alternate form of the VolumeSnapshots method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) VolumeSnapshotsWithContext(ctx context.Context, volumeSnapshotsOptions *sdsaasv1.VolumeSnapshotsOptions) (result *sdsaasv1.SnapshotCollection, response *core.DetailedResponse, err error) {
+	err = validate(volumeSnapshotsOptions, "volumeSnapshotsOptions")
+	if err != nil {
+		return
+	}
+	v2Result, response, err := adapter.client.ListSnapshotsWithContext(ctx, &sdsaasv2.ListSnapshotsOptions{
+		Start:          volumeSnapshotsOptions.Start,
+		Limit:          volumeSnapshotsOptions.Limit,
+		Name:           volumeSnapshotsOptions.Name,
+		SourceVolumeID: volumeSnapshotsOptions.SourceVolumeID,
+		Headers:        volumeSnapshotsOptions.Headers,
+	})
+	result = snapshotCollection(v2Result)
+	if result != nil {
+		response.Result = result
+	}
+	return
+}
+
+// VolumeSnapshotCreate : Create a snapshot
+// It calls sdsaasv2.SdsaasV2.CreateSnapshot.
+func (adapter *SdsaasV1Adapter) VolumeSnapshotCreate(volumeSnapshotCreateOptions *sdsaasv1.VolumeSnapshotCreateOptions) (result *sdsaasv1.Snapshot, response *core.DetailedResponse, err error) {
+	result, response, err = adapter.VolumeSnapshotCreateWithContext(context.Background(), volumeSnapshotCreateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// VolumeSnapshotCreateWithContext is an alternate form of the VolumeSnapshotCreate method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) VolumeSnapshotCreateWithContext(ctx context.Context, volumeSnapshotCreateOptions *sdsaasv1.VolumeSnapshotCreateOptions) (result *sdsaasv1.Snapshot, response *core.DetailedResponse, err error) {
+	err = validate(volumeSnapshotCreateOptions, "volumeSnapshotCreateOptions")
+	if err != nil {
+		return
+	}
+	v2Result, response, err := adapter.client.CreateSnapshotWithContext(ctx, &sdsaasv2.CreateSnapshotOptions{
+		Name:         volumeSnapshotCreateOptions.Name,
+		SourceVolume: &sdsaasv2.SourceVolumePrototype{ID: volumeSnapshotCreateOptions.SourceVolume.ID},
+		Headers:      volumeSnapshotCreateOptions.Headers,
+	})
+	result = snapshot(v2Result)
+	if result != nil {
+		response.Result = result
+	}
+	return
+}
+
+// VolumeSnapshotsDelete : Delete a filtered collection of snapshots
+// It calls sdsaasv2.SdsaasV2.DeleteSnapshots.
+func (adapter *SdsaasV1Adapter) VolumeSnapshotsDelete(volumeSnapshotsDeleteOptions *sdsaasv1.VolumeSnapshotsDeleteOptions) (response *core.DetailedResponse, err error) {
+	response, err = adapter.VolumeSnapshotsDeleteWithContext(context.Background(), volumeSnapshotsDeleteOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// VolumeSnapshotsDeleteWithContext is an alternate form of the VolumeSnapshotsDelete method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) VolumeSnapshotsDeleteWithContext(ctx context.Context, volumeSnapshotsDeleteOptions *sdsaasv1.VolumeSnapshotsDeleteOptions) (response *core.DetailedResponse, err error) {
+	err = validate(volumeSnapshotsDeleteOptions, "volumeSnapshotsDeleteOptions")
+	if err != nil {
+		return
+	}
+	if volumeSnapshotsDeleteOptions.SourceVolumeID == nil {
+		err = unsupported("VolumeSnapshotsDelete", "deleting snapshots without a source volume ID")
+		return
+	}
+	response, err = adapter.client.DeleteSnapshotsWithContext(ctx, &sdsaasv2.DeleteSnapshotsOptions{
+		SourceVolumeID: volumeSnapshotsDeleteOptions.SourceVolumeID,
+		Headers:        volumeSnapshotsDeleteOptions.Headers,
+	})
+	return
+}
+
+// VolumeSnapshot : Retrieve a single snapshot
+// It calls sdsaasv2.SdsaasV2.GetSnapshot.
+func (adapter *SdsaasV1Adapter) VolumeSnapshot(volumeSnapshotOptions *sdsaasv1.VolumeSnapshotOptions) (result *sdsaasv1.Snapshot, response *core.DetailedResponse, err error) {
+	result, response, err = adapter.VolumeSnapshotWithContext(context.Background(), volumeSnapshotOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// VolumeSnapshotWithContext is an alternate form of the VolumeSnapshot method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) VolumeSnapshotWithContext(ctx context.Context, volumeSnapshotOptions *sdsaasv1.VolumeSnapshotOptions) (result *sdsaasv1.Snapshot, response *core.DetailedResponse, err error) {
+	err = validate(volumeSnapshotOptions, "volumeSnapshotOptions")
+	if err != nil {
+		return
+	}
+	v2Result, response, err := adapter.client.GetSnapshotWithContext(ctx, &sdsaasv2.GetSnapshotOptions{
+		ID:      volumeSnapshotOptions.SnapID,
+		Headers: volumeSnapshotOptions.Headers,
+	})
+	result = snapshot(v2Result)
+	if result != nil {
+		response.Result = result
+	}
+	return
+}
+
+// VolumeSnapshotUpdate : Update a snapshot
+// It calls sdsaasv2.SdsaasV2.UpdateSnapshot.
+func (adapter *SdsaasV1Adapter) VolumeSnapshotUpdate(volumeSnapshotUpdateOptions *sdsaasv1.VolumeSnapshotUpdateOptions) (result *sdsaasv1.Snapshot, response *core.DetailedResponse, err error) {
+	result, response, err = adapter.VolumeSnapshotUpdateWithContext(context.Background(), volumeSnapshotUpdateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// VolumeSnapshotUpdateWithContext is an alternate form of the VolumeSnapshotUpdate method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) VolumeSnapshotUpdateWithContext(ctx context.Context, volumeSnapshotUpdateOptions *sdsaasv1.VolumeSnapshotUpdateOptions) (result *sdsaasv1.Snapshot, response *core.DetailedResponse, err error) {
+	err = validate(volumeSnapshotUpdateOptions, "volumeSnapshotUpdateOptions")
+	if err != nil {
+		return
+	}
+	v2Result, response, err := adapter.client.UpdateSnapshotWithContext(ctx, &sdsaasv2.UpdateSnapshotOptions{
+		ID:            volumeSnapshotUpdateOptions.SnapID,
+		SnapshotPatch: volumeSnapshotUpdateOptions.SnapshotPatch,
+		Headers:       volumeSnapshotUpdateOptions.Headers,
+	})
+	result = snapshot(v2Result)
+	if result != nil {
+		response.Result = result
+	}
+	return
+}
+
+// VolumeSnapshotDelete : Delete a single snapshot
+// It calls sdsaasv2.SdsaasV2.DeleteSnapshot.
+func (adapter *SdsaasV1Adapter) VolumeSnapshotDelete(volumeSnapshotDeleteOptions *sdsaasv1.VolumeSnapshotDeleteOptions) (response *core.DetailedResponse, err error) {
+	response, err = adapter.VolumeSnapshotDeleteWithContext(context.Background(), volumeSnapshotDeleteOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// VolumeSnapshotDeleteWithContext is an alternate form of the VolumeSnapshotDelete method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) VolumeSnapshotDeleteWithContext(ctx context.Context, volumeSnapshotDeleteOptions *sdsaasv1.VolumeSnapshotDeleteOptions) (response *core.DetailedResponse, err error) {
+	err = validate(volumeSnapshotDeleteOptions, "volumeSnapshotDeleteOptions")
+	if err != nil {
+		return
+	}
+	response, err = adapter.client.DeleteSnapshotWithContext(ctx, &sdsaasv2.DeleteSnapshotOptions{
+		ID:      volumeSnapshotDeleteOptions.SnapID,
+		Headers: volumeSnapshotDeleteOptions.Headers,
+	})
+	return
+}
+
+// Creds : List storage account credentials
+// It calls sdsaasv2.SdsaasV2.ListHmacCredentials.
+func (adapter *SdsaasV1Adapter) Creds(credsOptions *sdsaasv1.CredsOptions) (result *sdsaasv1.CredentialsFound, response *core.DetailedResponse, err error) {
+	result, response, err = adapter.CredsWithContext(context.Background(), credsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CredsWithContext is an alternate form of the Creds method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) CredsWithContext(ctx context.Context, credsOptions *sdsaasv1.CredsOptions) (result *sdsaasv1.CredentialsFound, response *core.DetailedResponse, err error) {
+	err = validate(credsOptions, "credsOptions")
+	if err != nil {
+		return
+	}
+	v2Result, response, err := adapter.client.ListHmacCredentialsWithContext(ctx, &sdsaasv2.ListHmacCredentialsOptions{
+		Headers: credsOptions.Headers,
+	})
+	result = credentialsFound(v2Result)
+	if result != nil {
+		response.Result = result
+	}
+	return
+}
+
+// CredCreate : Create or modify storage account credentials
+// It calls sdsaasv2.SdsaasV2.CreateHmacCredentials.
+func (adapter *SdsaasV1Adapter) CredCreate(credCreateOptions *sdsaasv1.CredCreateOptions) (result *sdsaasv1.CredentialsUpdated, response *core.DetailedResponse, err error) {
+	result, response, err = adapter.CredCreateWithContext(context.Background(), credCreateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CredCreateWithContext is an alternate form of the CredCreate method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) CredCreateWithContext(ctx context.Context, credCreateOptions *sdsaasv1.CredCreateOptions) (result *sdsaasv1.CredentialsUpdated, response *core.DetailedResponse, err error) {
+	err = validate(credCreateOptions, "credCreateOptions")
+	if err != nil {
+		return
+	}
+	v2Result, response, err := adapter.client.CreateHmacCredentialsWithContext(ctx, &sdsaasv2.CreateHmacCredentialsOptions{
+		AccessKey: credCreateOptions.AccessKey,
+		Headers:   credCreateOptions.Headers,
+	})
+	result = credentialsUpdated(v2Result)
+	if result != nil {
+		response.Result = result
+	}
+	return
+}
+
+// CredDelete : Delete storage account credentials
+// It calls sdsaasv2.SdsaasV2.DeleteHmacCredentials.
+func (adapter *SdsaasV1Adapter) CredDelete(credDeleteOptions *sdsaasv1.CredDeleteOptions) (response *core.DetailedResponse, err error) {
+	response, err = adapter.CredDeleteWithContext(context.Background(), credDeleteOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CredDeleteWithContext is an alternate form of the CredDelete method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) CredDeleteWithContext(ctx context.Context, credDeleteOptions *sdsaasv1.CredDeleteOptions) (response *core.DetailedResponse, err error) {
+	err = validate(credDeleteOptions, "credDeleteOptions")
+	if err != nil {
+		return
+	}
+	response, err = adapter.client.DeleteHmacCredentialsWithContext(ctx, &sdsaasv2.DeleteHmacCredentialsOptions{
+		AccessKey: credDeleteOptions.AccessKey,
+		Headers:   credDeleteOptions.Headers,
+	})
+	return
+}
+
+// CertTypes : List the allowed certificate types
+// It calls sdsaasv2.SdsaasV2.ListCertificates.
+func (adapter *SdsaasV1Adapter) CertTypes(certTypesOptions *sdsaasv1.CertTypesOptions) (result *sdsaasv1.CertificateList, response *core.DetailedResponse, err error) {
+	result, response, err = adapter.CertTypesWithContext(context.Background(), certTypesOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CertTypesWithContext is an alternate form of the CertTypes method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) CertTypesWithContext(ctx context.Context, certTypesOptions *sdsaasv1.CertTypesOptions) (result *sdsaasv1.CertificateList, response *core.DetailedResponse, err error) {
+	err = validate(certTypesOptions, "certTypesOptions")
+	if err != nil {
+		return
+	}
+	v2Result, response, err := adapter.client.ListCertificatesWithContext(ctx, &sdsaasv2.ListCertificatesOptions{
+		Headers: certTypesOptions.Headers,
+	})
+	result = certificateList(v2Result)
+	if result != nil {
+		response.Result = result
+	}
+	return
+}
+
+// Cert : Retrieves the SSL certificate expiration date and status
+// It calls sdsaasv2.SdsaasV2.GetS3SslCertStatus.
+func (adapter *SdsaasV1Adapter) Cert(certOptions *sdsaasv1.CertOptions) (result *sdsaasv1.CertificateFound, response *core.DetailedResponse, err error) {
+	result, response, err = adapter.CertWithContext(context.Background(), certOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CertWithContext is an alternate form of the Cert method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) CertWithContext(ctx context.Context, certOptions *sdsaasv1.CertOptions) (result *sdsaasv1.CertificateFound, response *core.DetailedResponse, err error) {
+	err = validate(certOptions, "certOptions")
+	if err != nil {
+		return
+	}
+	v2Result, response, err := adapter.client.GetS3SslCertStatusWithContext(ctx, &sdsaasv2.GetS3SslCertStatusOptions{
+		CertType: certOptions.Cert,
+		Headers:  certOptions.Headers,
+	})
+	result = certificateFound(v2Result)
+	if result != nil {
+		response.Result = result
+	}
+	return
+}
+
+// CertDelete : Delete SSL certificate
+// It calls sdsaasv2.SdsaasV2.DeleteSslCert.
+func (adapter *SdsaasV1Adapter) CertDelete(certDeleteOptions *sdsaasv1.CertDeleteOptions) (response *core.DetailedResponse, err error) {
+	response, err = adapter.CertDeleteWithContext(context.Background(), certDeleteOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CertDeleteWithContext is an alternate form of the CertDelete method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) CertDeleteWithContext(ctx context.Context, certDeleteOptions *sdsaasv1.CertDeleteOptions) (response *core.DetailedResponse, err error) {
+	err = validate(certDeleteOptions, "certDeleteOptions")
+	if err != nil {
+		return
+	}
+	response, err = adapter.client.DeleteSslCertWithContext(ctx, &sdsaasv2.DeleteSslCertOptions{
+		CertType: certDeleteOptions.Cert,
+		Headers:  certDeleteOptions.Headers,
+	})
+	return
+}
+
+// CertCreate : Creates a new SSL Certificate
+// It calls sdsaasv2.SdsaasV2.CreateSslCert.
+func (adapter *SdsaasV1Adapter) CertCreate(certCreateOptions *sdsaasv1.CertCreateOptions) (result *sdsaasv1.CertificateUpdated, response *core.DetailedResponse, err error) {
+	result, response, err = adapter.CertCreateWithContext(context.Background(), certCreateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CertCreateWithContext is an alternate form of the CertCreate method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) CertCreateWithContext(ctx context.Context, certCreateOptions *sdsaasv1.CertCreateOptions) (result *sdsaasv1.CertificateUpdated, response *core.DetailedResponse, err error) {
+	err = validate(certCreateOptions, "certCreateOptions")
+	if err != nil {
+		return
+	}
+	v2Result, response, err := adapter.client.CreateSslCertWithContext(ctx, &sdsaasv2.CreateSslCertOptions{
+		CertType: certCreateOptions.Cert,
+		Body:     certCreateOptions.Body,
+		Headers:  certCreateOptions.Headers,
+	})
+	result = certificateUpdated(v2Result)
+	if result != nil {
+		response.Result = result
+	}
+	return
+}
+
+// CertUpdate : Updates the SSL Certificate
+// It calls sdsaasv2.SdsaasV2.ReplaceSslCert.
+func (adapter *SdsaasV1Adapter) CertUpdate(certUpdateOptions *sdsaasv1.CertUpdateOptions) (result *sdsaasv1.CertificateUpdated, response *core.DetailedResponse, err error) {
+	result, response, err = adapter.CertUpdateWithContext(context.Background(), certUpdateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CertUpdateWithContext is an alternate form of the CertUpdate method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) CertUpdateWithContext(ctx context.Context, certUpdateOptions *sdsaasv1.CertUpdateOptions) (result *sdsaasv1.CertificateUpdated, response *core.DetailedResponse, err error) {
+	err = validate(certUpdateOptions, "certUpdateOptions")
+	if err != nil {
+		return
+	}
+	v2Result, response, err := adapter.client.ReplaceSslCertWithContext(ctx, &sdsaasv2.ReplaceSslCertOptions{
+		CertType: certUpdateOptions.Cert,
+		Body:     certUpdateOptions.Body,
+		Headers:  certUpdateOptions.Headers,
+	})
+	result = certificateUpdated(v2Result)
+	if result != nil {
+		response.Result = result
+	}
+	return
+}
+
+// Hosts : Lists all hosts and all host IDs
+// It calls sdsaasv2.SdsaasV2.ListHosts.
+func (adapter *SdsaasV1Adapter) Hosts(hostsOptions *sdsaasv1.HostsOptions) (result *sdsaasv1.HostCollection, response *core.DetailedResponse, err error) {
+	result, response, err = adapter.HostsWithContext(context.Background(), hostsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// HostsWithContext is an alternate form of the Hosts method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) HostsWithContext(ctx context.Context, hostsOptions *sdsaasv1.HostsOptions) (result *sdsaasv1.HostCollection, response *core.DetailedResponse, err error) {
+	err = validate(hostsOptions, "hostsOptions")
+	if err != nil {
+		return
+	}
+	v2Result, response, err := adapter.client.ListHostsWithContext(ctx, &sdsaasv2.ListHostsOptions{
+		Limit:   hostsOptions.Limit,
+		Name:    hostsOptions.Name,
+		Headers: hostsOptions.Headers,
+	})
+	result = hostCollection(v2Result)
+	if result != nil {
+		response.Result = result
+	}
+	return
+}
+
+// HostCreate : Creates a host
+// It calls sdsaasv2.SdsaasV2.CreateHost.
+func (adapter *SdsaasV1Adapter) HostCreate(hostCreateOptions *sdsaasv1.HostCreateOptions) (result *sdsaasv1.Host, response *core.DetailedResponse, err error) {
+	result, response, err = adapter.HostCreateWithContext(context.Background(), hostCreateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// HostCreateWithContext is an alternate form of the HostCreate method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) HostCreateWithContext(ctx context.Context, hostCreateOptions *sdsaasv1.HostCreateOptions) (result *sdsaasv1.Host, response *core.DetailedResponse, err error) {
+	err = validate(hostCreateOptions, "hostCreateOptions")
+	if err != nil {
+		return
+	}
+	v2Result, response, err := adapter.client.CreateHostWithContext(ctx, &sdsaasv2.CreateHostOptions{
+		Nqn:            hostCreateOptions.Nqn,
+		Name:           hostCreateOptions.Name,
+		Psk:            hostCreateOptions.Psk,
+		VolumeMappings: convertAll(hostCreateOptions.VolumeMappings, volumeMappingPrototype),
+		Headers:        hostCreateOptions.Headers,
+	})
+	result = hostSummary(v2Result)
+	if result != nil {
+		response.Result = result
+	}
+	return
+}
+
+// Host : Retrieve a host by ID
+// It calls sdsaasv2.SdsaasV2.GetHost.
+func (adapter *SdsaasV1Adapter) Host(hostOptions *sdsaasv1.HostOptions) (result *sdsaasv1.Host, response *core.DetailedResponse, err error) {
+	result, response, err = adapter.HostWithContext(context.Background(), hostOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// HostWithContext is an alternate form of the Host method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) HostWithContext(ctx context.Context, hostOptions *sdsaasv1.HostOptions) (result *sdsaasv1.Host, response *core.DetailedResponse, err error) {
+	err = validate(hostOptions, "hostOptions")
+	if err != nil {
+		return
+	}
+	v2Result, response, err := adapter.client.GetHostWithContext(ctx, &sdsaasv2.GetHostOptions{
+		ID:      hostOptions.HostID,
+		Headers: hostOptions.Headers,
+	})
+	result = host(v2Result)
+	if result != nil {
+		response.Result = result
+	}
+	return
+}
+
+// HostUpdate : Update a host
+// It calls sdsaasv2.SdsaasV2.UpdateHost.
+func (adapter *SdsaasV1Adapter) HostUpdate(hostUpdateOptions *sdsaasv1.HostUpdateOptions) (result *sdsaasv1.Host, response *core.DetailedResponse, err error) {
+	result, response, err = adapter.HostUpdateWithContext(context.Background(), hostUpdateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// HostUpdateWithContext is an alternate form of the HostUpdate method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) HostUpdateWithContext(ctx context.Context, hostUpdateOptions *sdsaasv1.HostUpdateOptions) (result *sdsaasv1.Host, response *core.DetailedResponse, err error) {
+	err = validate(hostUpdateOptions, "hostUpdateOptions")
+	if err != nil {
+		return
+	}
+	v2Result, response, err := adapter.client.UpdateHostWithContext(ctx, &sdsaasv2.UpdateHostOptions{
+		ID:        hostUpdateOptions.HostID,
+		HostPatch: patch(hostUpdateOptions.HostPatch),
+		Headers:   hostUpdateOptions.Headers,
+	})
+	result = host(v2Result)
+	if result != nil {
+		response.Result = result
+	}
+	return
+}
+
+// HostDelete : Delete a specific host
+// It calls sdsaasv2.SdsaasV2.DeleteHost.
+func (adapter *SdsaasV1Adapter) HostDelete(hostDeleteOptions *sdsaasv1.HostDeleteOptions) (response *core.DetailedResponse, err error) {
+	response, err = adapter.HostDeleteWithContext(context.Background(), hostDeleteOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// HostDeleteWithContext is an alternate form of the HostDelete method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) HostDeleteWithContext(ctx context.Context, hostDeleteOptions *sdsaasv1.HostDeleteOptions) (response *core.DetailedResponse, err error) {
+	err = validate(hostDeleteOptions, "hostDeleteOptions")
+	if err != nil {
+		return
+	}
+	response, err = adapter.client.DeleteHostWithContext(ctx, &sdsaasv2.DeleteHostOptions{
+		ID:      hostDeleteOptions.HostID,
+		Headers: hostDeleteOptions.Headers,
+	})
+	return
+}
+
+// HostMappings : List all volume mappings for a host
+// It calls sdsaasv2.SdsaasV2.ListVolumeMappings.
+func (adapter *SdsaasV1Adapter) HostMappings(hostMappingsOptions *sdsaasv1.HostMappingsOptions) (result *sdsaasv1.VolumeMappingCollection, response *core.DetailedResponse, err error) {
+	result, response, err = adapter.HostMappingsWithContext(context.Background(), hostMappingsOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// HostMappingsWithContext is an alternate form of the HostMappings method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) HostMappingsWithContext(ctx context.Context, hostMappingsOptions *sdsaasv1.HostMappingsOptions) (result *sdsaasv1.VolumeMappingCollection, response *core.DetailedResponse, err error) {
+	err = validate(hostMappingsOptions, "hostMappingsOptions")
+	if err != nil {
+		return
+	}
+	v2Result, response, err := adapter.client.ListVolumeMappingsWithContext(ctx, &sdsaasv2.ListVolumeMappingsOptions{
+		ID:      hostMappingsOptions.HostID,
+		Headers: hostMappingsOptions.Headers,
+	})
+	result = volumeMappingCollection(v2Result)
+	if result != nil {
+		response.Result = result
+	}
+	return
+}
+
+// HostMappingCreate : Create a Volume mapping for a host
+// It calls sdsaasv2.SdsaasV2.CreateVolumeMapping.
+func (adapter *SdsaasV1Adapter) HostMappingCreate(hostMappingCreateOptions *sdsaasv1.HostMappingCreateOptions) (result *sdsaasv1.VolumeMapping, response *core.DetailedResponse, err error) {
+	result, response, err = adapter.HostMappingCreateWithContext(context.Background(), hostMappingCreateOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// HostMappingCreateWithContext is an alternate form of the HostMappingCreate method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) HostMappingCreateWithContext(ctx context.Context, hostMappingCreateOptions *sdsaasv1.HostMappingCreateOptions) (result *sdsaasv1.VolumeMapping, response *core.DetailedResponse, err error) {
+	err = validate(hostMappingCreateOptions, "hostMappingCreateOptions")
+	if err != nil {
+		return
+	}
+	v2Result, response, err := adapter.client.CreateVolumeMappingWithContext(ctx, &sdsaasv2.CreateVolumeMappingOptions{
+		ID:      hostMappingCreateOptions.HostID,
+		Volume:  &sdsaasv2.VolumeIdentity{ID: hostMappingCreateOptions.Volume.ID},
+		Headers: hostMappingCreateOptions.Headers,
+	})
+	result = volumeMappingReference(v2Result)
+	if result != nil {
+		response.Result = result
+	}
+	return
+}
+
+// HostMappingDeleteAll : Deletes all the volume mappings for a given host
+// It calls sdsaasv2.SdsaasV2.DeleteVolumeMappings.
+func (adapter *SdsaasV1Adapter) HostMappingDeleteAll(hostMappingDeleteAllOptions *sdsaasv1.HostMappingDeleteAllOptions) (response *core.DetailedResponse, err error) {
+	response, err = adapter.HostMappingDeleteAllWithContext(context.Background(), hostMappingDeleteAllOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// HostMappingDeleteAllWithContext is an alternate form of the HostMappingDeleteAll method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) HostMappingDeleteAllWithContext(ctx context.Context, hostMappingDeleteAllOptions *sdsaasv1.HostMappingDeleteAllOptions) (response *core.DetailedResponse, err error) {
+	err = validate(hostMappingDeleteAllOptions, "hostMappingDeleteAllOptions")
+	if err != nil {
+		return
+	}
+	response, err = adapter.client.DeleteVolumeMappingsWithContext(ctx, &sdsaasv2.DeleteVolumeMappingsOptions{
+		ID:      hostMappingDeleteAllOptions.HostID,
+		Headers: hostMappingDeleteAllOptions.Headers,
+	})
+	return
+}
+
+// HostMapping : Retrieve a volume mapping
+// It calls sdsaasv2.SdsaasV2.GetVolumeMapping.
+func (adapter *SdsaasV1Adapter) HostMapping(hostMappingOptions *sdsaasv1.HostMappingOptions) (result *sdsaasv1.VolumeMapping, response *core.DetailedResponse, err error) {
+	result, response, err = adapter.HostMappingWithContext(context.Background(), hostMappingOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// HostMappingWithContext is an alternate form of the HostMapping method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) HostMappingWithContext(ctx context.Context, hostMappingOptions *sdsaasv1.HostMappingOptions) (result *sdsaasv1.VolumeMapping, response *core.DetailedResponse, err error) {
+	err = validate(hostMappingOptions, "hostMappingOptions")
+	if err != nil {
+		return
+	}
+	v2Result, response, err := adapter.client.GetVolumeMappingWithContext(ctx, &sdsaasv2.GetVolumeMappingOptions{
+		ID:              hostMappingOptions.HostID,
+		VolumeMappingID: hostMappingOptions.VolumeMappingID,
+		Headers:         hostMappingOptions.Headers,
+	})
+	result = volumeMapping(v2Result)
+	if result != nil {
+		response.Result = result
+	}
+	return
+}
+
+// HostMappingDelete : Deletes the given volume mapping for a specific host
+// It calls sdsaasv2.SdsaasV2.DeleteVolumeMapping.
+func (adapter *SdsaasV1Adapter) HostMappingDelete(hostMappingDeleteOptions *sdsaasv1.HostMappingDeleteOptions) (response *core.DetailedResponse, err error) {
+	response, err = adapter.HostMappingDeleteWithContext(context.Background(), hostMappingDeleteOptions)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// HostMappingDeleteWithContext is an alternate form of the HostMappingDelete method which supports a Context parameter
+func (adapter *SdsaasV1Adapter) HostMappingDeleteWithContext(ctx context.Context, hostMappingDeleteOptions *sdsaasv1.HostMappingDeleteOptions) (response *core.DetailedResponse, err error) {
+	err = validate(hostMappingDeleteOptions, "hostMappingDeleteOptions")
+	if err != nil {
+		return
+	}
+	response, err = adapter.client.DeleteVolumeMappingWithContext(ctx, &sdsaasv2.DeleteVolumeMappingOptions{
+		ID:              hostMappingDeleteOptions.HostID,
+		VolumeMappingID: hostMappingDeleteOptions.VolumeMappingID,
+		Headers:         hostMappingDeleteOptions.Headers,
+	})
+	return
+}
+
+// NewCertCreateOptions : Instantiate CertCreateOptions
+func (*SdsaasV1Adapter) NewCertCreateOptions(cert string, body io.ReadCloser) *sdsaasv1.CertCreateOptions {
+	return v1.NewCertCreateOptions(cert, body)
+}
+
+// NewCertDeleteOptions : Instantiate CertDeleteOptions
+func (*SdsaasV1Adapter) NewCertDeleteOptions(cert string) *sdsaasv1.CertDeleteOptions {
+	return v1.NewCertDeleteOptions(cert)
+}
+
+// NewCertOptions : Instantiate CertOptions
+func (*SdsaasV1Adapter) NewCertOptions(cert string) *sdsaasv1.CertOptions {
+	return v1.NewCertOptions(cert)
+}
+
+// NewCertTypesOptions : Instantiate CertTypesOptions
+func (*SdsaasV1Adapter) NewCertTypesOptions() *sdsaasv1.CertTypesOptions {
+	return v1.NewCertTypesOptions()
+}
+
+// NewCertUpdateOptions : Instantiate CertUpdateOptions
+func (*SdsaasV1Adapter) NewCertUpdateOptions(cert string, body io.ReadCloser) *sdsaasv1.CertUpdateOptions {
+	return v1.NewCertUpdateOptions(cert, body)
+}
+
+// NewCredCreateOptions : Instantiate CredCreateOptions
+func (*SdsaasV1Adapter) NewCredCreateOptions(accessKey string) *sdsaasv1.CredCreateOptions {
+	return v1.NewCredCreateOptions(accessKey)
+}
+
+// NewCredDeleteOptions : Instantiate CredDeleteOptions
+func (*SdsaasV1Adapter) NewCredDeleteOptions(accessKey string) *sdsaasv1.CredDeleteOptions {
+	return v1.NewCredDeleteOptions(accessKey)
+}
+
+// NewCredsOptions : Instantiate CredsOptions
+func (*SdsaasV1Adapter) NewCredsOptions() *sdsaasv1.CredsOptions {
+	return v1.NewCredsOptions()
+}
+
+// NewHostCreateOptions : Instantiate HostCreateOptions
+func (*SdsaasV1Adapter) NewHostCreateOptions(nqn string) *sdsaasv1.HostCreateOptions {
+	return v1.NewHostCreateOptions(nqn)
+}
+
+// NewHostDeleteOptions : Instantiate HostDeleteOptions
+func (*SdsaasV1Adapter) NewHostDeleteOptions(hostID string) *sdsaasv1.HostDeleteOptions {
+	return v1.NewHostDeleteOptions(hostID)
+}
+
+// NewHostMappingCreateOptions : Instantiate HostMappingCreateOptions
+func (*SdsaasV1Adapter) NewHostMappingCreateOptions(hostID string, volume *sdsaasv1.VolumeIdentity) *sdsaasv1.HostMappingCreateOptions {
+	return v1.NewHostMappingCreateOptions(hostID, volume)
+}
+
+// NewHostMappingDeleteAllOptions : Instantiate HostMappingDeleteAllOptions
+func (*SdsaasV1Adapter) NewHostMappingDeleteAllOptions(hostID string) *sdsaasv1.HostMappingDeleteAllOptions {
+	return v1.NewHostMappingDeleteAllOptions(hostID)
+}
+
+// NewHostMappingDeleteOptions : Instantiate HostMappingDeleteOptions
+func (*SdsaasV1Adapter) NewHostMappingDeleteOptions(hostID string, volumeMappingID string) *sdsaasv1.HostMappingDeleteOptions {
+	return v1.NewHostMappingDeleteOptions(hostID, volumeMappingID)
+}
+
+// NewHostMappingOptions : Instantiate HostMappingOptions
+func (*SdsaasV1Adapter) NewHostMappingOptions(hostID string, volumeMappingID string) *sdsaasv1.HostMappingOptions {
+	return v1.NewHostMappingOptions(hostID, volumeMappingID)
+}
+
+// NewHostMappingsOptions : Instantiate HostMappingsOptions
+func (*SdsaasV1Adapter) NewHostMappingsOptions(hostID string) *sdsaasv1.HostMappingsOptions {
+	return v1.NewHostMappingsOptions(hostID)
+}
+
+// NewHostOptions : Instantiate HostOptions
+func (*SdsaasV1Adapter) NewHostOptions(hostID string) *sdsaasv1.HostOptions {
+	return v1.NewHostOptions(hostID)
+}
+
+// NewHostUpdateOptions : Instantiate HostUpdateOptions
+func (*SdsaasV1Adapter) NewHostUpdateOptions(hostID string) *sdsaasv1.HostUpdateOptions {
+	return v1.NewHostUpdateOptions(hostID)
+}
+
+// NewHostsOptions : Instantiate HostsOptions
+func (*SdsaasV1Adapter) NewHostsOptions() *sdsaasv1.HostsOptions {
+	return v1.NewHostsOptions()
+}
+
+// NewSourceSnapshot : Instantiate SourceSnapshot
+func (*SdsaasV1Adapter) NewSourceSnapshot(id string) (_model *sdsaasv1.SourceSnapshot, err error) {
+	return v1.NewSourceSnapshot(id)
+}
+
+// NewSourceVolumePrototype : Instantiate SourceVolumePrototype
+func (*SdsaasV1Adapter) NewSourceVolumePrototype(id string) (_model *sdsaasv1.SourceVolumePrototype, err error) {
+	return v1.NewSourceVolumePrototype(id)
+}
+
+// NewVolumeCreateOptions : Instantiate VolumeCreateOptions
+func (*SdsaasV1Adapter) NewVolumeCreateOptions(capacity int64) *sdsaasv1.VolumeCreateOptions {
+	return v1.NewVolumeCreateOptions(capacity)
+}
+
+// NewVolumeDeleteOptions : Instantiate VolumeDeleteOptions
+func (*SdsaasV1Adapter) NewVolumeDeleteOptions(volumeID string) *sdsaasv1.VolumeDeleteOptions {
+	return v1.NewVolumeDeleteOptions(volumeID)
+}
+
+// NewVolumeIdentity : Instantiate VolumeIdentity
+func (*SdsaasV1Adapter) NewVolumeIdentity(id string) (_model *sdsaasv1.VolumeIdentity, err error) {
+	return v1.NewVolumeIdentity(id)
+}
+
+// NewVolumeMappingPrototype : Instantiate VolumeMappingPrototype
+func (*SdsaasV1Adapter) NewVolumeMappingPrototype(volume *sdsaasv1.VolumeIdentity) (_model *sdsaasv1.VolumeMappingPrototype, err error) {
+	return v1.NewVolumeMappingPrototype(volume)
+}
+
+// NewVolumeOptions : Instantiate VolumeOptions
+func (*SdsaasV1Adapter) NewVolumeOptions(volumeID string) *sdsaasv1.VolumeOptions {
+	return v1.NewVolumeOptions(volumeID)
+}
+
+// NewVolumeSnapshotCreateOptions : Instantiate VolumeSnapshotCreateOptions
+func (*SdsaasV1Adapter) NewVolumeSnapshotCreateOptions(sourceVolume *sdsaasv1.SourceVolumePrototype) *sdsaasv1.VolumeSnapshotCreateOptions {
+	return v1.NewVolumeSnapshotCreateOptions(sourceVolume)
+}
+
+// NewVolumeSnapshotDeleteOptions : Instantiate VolumeSnapshotDeleteOptions
+func (*SdsaasV1Adapter) NewVolumeSnapshotDeleteOptions(snapID string) *sdsaasv1.VolumeSnapshotDeleteOptions {
+	return v1.NewVolumeSnapshotDeleteOptions(snapID)
+}
+
+// NewVolumeSnapshotOptions : Instantiate VolumeSnapshotOptions
+func (*SdsaasV1Adapter) NewVolumeSnapshotOptions(snapID string) *sdsaasv1.VolumeSnapshotOptions {
+	return v1.NewVolumeSnapshotOptions(snapID)
+}
+
+// NewVolumeSnapshotUpdateOptions : Instantiate VolumeSnapshotUpdateOptions
+func (*SdsaasV1Adapter) NewVolumeSnapshotUpdateOptions(snapID string, snapshotPatch map[string]interface{}) *sdsaasv1.VolumeSnapshotUpdateOptions {
+	return v1.NewVolumeSnapshotUpdateOptions(snapID, snapshotPatch)
+}
+
+// NewVolumeSnapshotsDeleteOptions : Instantiate VolumeSnapshotsDeleteOptions
+func (*SdsaasV1Adapter) NewVolumeSnapshotsDeleteOptions() *sdsaasv1.VolumeSnapshotsDeleteOptions {
+	return v1.NewVolumeSnapshotsDeleteOptions()
+}
+
+// NewVolumeSnapshotsOptions : Instantiate VolumeSnapshotsOptions
+func (*SdsaasV1Adapter) NewVolumeSnapshotsOptions() *sdsaasv1.VolumeSnapshotsOptions {
+	return v1.NewVolumeSnapshotsOptions()
+}
+
+// NewVolumeUpdateOptions : Instantiate VolumeUpdateOptions
+func (*SdsaasV1Adapter) NewVolumeUpdateOptions(volumeID string) *sdsaasv1.VolumeUpdateOptions {
+	return v1.NewVolumeUpdateOptions(volumeID)
+}
+
+// NewVolumesOptions : Instantiate VolumesOptions
+func (*SdsaasV1Adapter) NewVolumesOptions() *sdsaasv1.VolumesOptions {
+	return v1.NewVolumesOptions()
+}
+
+// VolumesPager can be used to simplify the use of the "Volumes" method.
+type VolumesPager struct {
+	pager *sdsaasv2.VolumesPager
+}
+
+// NewVolumesPager returns a new VolumesPager instance.
+func (adapter *SdsaasV1Adapter) NewVolumesPager(options *sdsaasv1.VolumesOptions) (pager *VolumesPager, err error) {
+	v2Pager, err := adapter.client.NewVolumesPager(&sdsaasv2.ListVolumesOptions{
+		Start:   options.Start,
+		Limit:   options.Limit,
+		Name:    options.Name,
+		Headers: options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	pager = &VolumesPager{pager: v2Pager}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *VolumesPager) HasNext() bool {
+	return pager.pager.HasNext()
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *VolumesPager) GetNextWithContext(ctx context.Context) (page []sdsaasv1.Volume, err error) {
+	v2Page, err := pager.pager.GetNextWithContext(ctx)
+	page = convertAll(v2Page, volume)
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *VolumesPager) GetAllWithContext(ctx context.Context) (allItems []sdsaasv1.Volume, err error) {
+	v2Items, err := pager.pager.GetAllWithContext(ctx)
+	allItems = convertAll(v2Items, volume)
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *VolumesPager) GetNext() (page []sdsaasv1.Volume, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *VolumesPager) GetAll() (allItems []sdsaasv1.Volume, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// VolumeSnapshotsPager can be used to simplify the use of the "VolumeSnapshots" method.
+type VolumeSnapshotsPager struct {
+	pager *sdsaasv2.SnapshotsPager
+}
+
+// NewVolumeSnapshotsPager returns a new VolumeSnapshotsPager instance.
+func (adapter *SdsaasV1Adapter) NewVolumeSnapshotsPager(options *sdsaasv1.VolumeSnapshotsOptions) (pager *VolumeSnapshotsPager, err error) {
+	v2Pager, err := adapter.client.NewSnapshotsPager(&sdsaasv2.ListSnapshotsOptions{
+		Start:          options.Start,
+		Limit:          options.Limit,
+		Name:           options.Name,
+		SourceVolumeID: options.SourceVolumeID,
+		Headers:        options.Headers,
+	})
+	if err != nil {
+		return
+	}
+	pager = &VolumeSnapshotsPager{pager: v2Pager}
+	return
+}
+
+// HasNext returns true if there are potentially more results to be retrieved.
+func (pager *VolumeSnapshotsPager) HasNext() bool {
+	return pager.pager.HasNext()
+}
+
+// GetNextWithContext returns the next page of results using the specified Context.
+func (pager *VolumeSnapshotsPager) GetNextWithContext(ctx context.Context) (page []sdsaasv1.Snapshot, err error) {
+	v2Page, err := pager.pager.GetNextWithContext(ctx)
+	page = convertAll(v2Page, snapshot)
+	return
+}
+
+// GetAllWithContext returns all results by invoking GetNextWithContext() repeatedly
+// until all pages of results have been retrieved.
+func (pager *VolumeSnapshotsPager) GetAllWithContext(ctx context.Context) (allItems []sdsaasv1.Snapshot, err error) {
+	v2Items, err := pager.pager.GetAllWithContext(ctx)
+	allItems = convertAll(v2Items, snapshot)
+	return
+}
+
+// GetNext invokes GetNextWithContext() using context.Background() as the Context parameter.
+func (pager *VolumeSnapshotsPager) GetNext() (page []sdsaasv1.Snapshot, err error) {
+	page, err = pager.GetNextWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// GetAll invokes GetAllWithContext() using context.Background() as the Context parameter.
+func (pager *VolumeSnapshotsPager) GetAll() (allItems []sdsaasv1.Snapshot, err error) {
+	allItems, err = pager.GetAllWithContext(context.Background())
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// validate checks the sdsaasv1 options of an operation, as sdsaasv1 does.
+func validate(options interface{}, name string) error {
+	err := core.ValidateNotNil(options, name+" cannot be nil")
+	if err != nil {
+		return core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+	}
+	err = core.ValidateStruct(options, name)
+	if err != nil {
+		return core.SDKErrorf(err, "", "struct-validation-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// unsupported returns the error of a request of an operation that has no sdsaasv2 equivalent.
+func unsupported(operation string, request string) error {
+	err := fmt.Errorf("%w: %s: %s", ErrUnsupportedOperation, operation, request)
+	return core.SDKErrorf(err, "", "unsupported-operation", common.GetComponentInfo())
+}
+
+// patch returns an optional sdsaasv1 patch as the required patch of sdsaasv2; an empty patch changes nothing.
+func patch(patch map[string]interface{}) map[string]interface{} {
+	if patch == nil {
+		return map[string]interface{}{}
+	}
+	return patch
+}
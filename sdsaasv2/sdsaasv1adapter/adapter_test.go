@@ -0,0 +1,113 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv1adapter
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv1"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestAdapter returns an adapter whose client sends its requests to a handler.
+func newTestAdapter(t *testing.T, handler http.HandlerFunc) *SdsaasV1Adapter {
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+	client, err := sdsaasv2.NewSdsaasV2(&sdsaasv2.SdsaasV2Options{URL: server.URL, Authenticator: &core.NoAuthAuthenticator{}})
+	require.Nil(t, err)
+	adapter, err := NewSdsaasV1Adapter(client)
+	require.Nil(t, err)
+	return adapter
+}
+
+func TestAdapterTranslatesVolumeMappings(t *testing.T) {
+	var requests []string
+	adapter := newTestAdapter(t, func(res http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		res.Header().Set("Content-type", "application/json")
+		fmt.Fprint(res, `{"id": "m1", "status": "mapped", "href": "https://sds/hosts/h1/volume_mappings/m1",
+			"volume": {"id": "v1", "name": "data"}, "host": {"id": "h1", "name": "node", "nqn": "nqn.host"},
+			"subsystem_nqn": "nqn.subsystem", "namespace": {"id": 7, "uuid": "ns-uuid"},
+			"gateways": [{"ip_address": "10.0.0.1", "port": 4420}]}`)
+	})
+
+	var api SdsaasV1API = adapter
+	mapping, response, err := api.HostMapping(adapter.NewHostMappingOptions("h1", "m1"))
+	require.Nil(t, err)
+	assert.Equal(t, []string{"GET /hosts/h1/volume_mappings/m1"}, requests)
+	assert.Same(t, mapping, response.Result)
+	assert.Equal(t, "data", *mapping.Volume.Name)
+	assert.Equal(t, &sdsaasv1.StorageIdentifier{
+		SubsystemNqn:  core.StringPtr("nqn.subsystem"),
+		NamespaceID:   core.Int64Ptr(7),
+		NamespaceUUID: core.StringPtr("ns-uuid"),
+		Gateways:      []sdsaasv1.Gateway{{IPAddress: core.StringPtr("10.0.0.1"), Port: core.Int64Ptr(4420)}},
+	}, mapping.StorageIdentifier)
+	assert.Equal(t, mapping.StorageIdentifier.Gateways, mapping.Gateways)
+}
+
+func TestAdapterSendsV2Requests(t *testing.T) {
+	var requests []string
+	adapter := newTestAdapter(t, func(res http.ResponseWriter, req *http.Request) {
+		requests = append(requests, req.Method+" "+req.URL.RequestURI())
+		res.Header().Set("Content-type", "application/json")
+		switch {
+		case req.Method == http.MethodPost:
+			res.WriteHeader(201)
+			fmt.Fprint(res, `{"id": "v1", "name": "data", "capacity": 10, "status": "pending", "status_reasons": []}`)
+		case req.URL.Query().Get("start") == "":
+			fmt.Fprint(res, `{"volumes": [{"id": "v1"}], "next": {"href": "https://sds/volumes?start=v2"}, "total_count": 2}`)
+		default:
+			fmt.Fprint(res, `{"volumes": [{"id": "v2"}], "total_count": 2}`)
+		}
+	})
+
+	sourceSnapshot, err := adapter.NewSourceSnapshot("s1")
+	require.Nil(t, err)
+	volume, _, err := adapter.VolumeCreate(adapter.NewVolumeCreateOptions(10).SetName("data").SetSourceSnapshot(sourceSnapshot))
+	require.Nil(t, err)
+	assert.Equal(t, "pending", *volume.Status)
+
+	pager, err := adapter.NewVolumesPager(adapter.NewVolumesOptions().SetLimit(1))
+	require.Nil(t, err)
+	volumes, err := pager.GetAll()
+	require.Nil(t, err)
+	require.Len(t, volumes, 2)
+	assert.Equal(t, "v2", *volumes[1].ID)
+	assert.Equal(t, []string{"POST /volumes", "GET /volumes?limit=1", "GET /volumes?limit=1&start=v2"}, requests)
+
+	_, _, err = adapter.Volume(nil)
+	assert.NotNil(t, err)
+}
+
+func TestAdapterUnsupportedOperations(t *testing.T) {
+	adapter := newTestAdapter(t, func(res http.ResponseWriter, req *http.Request) {
+		t.Errorf("unexpected request %s %s", req.Method, req.URL)
+	})
+
+	_, err := adapter.VolumeSnapshotsDelete(adapter.NewVolumeSnapshotsDeleteOptions())
+	require.NotNil(t, err)
+	assert.True(t, errors.Is(err, ErrUnsupportedOperation))
+	assert.Contains(t, err.Error(), "VolumeSnapshotsDelete")
+}
@@ -0,0 +1,335 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv1adapter
+
+import (
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv1"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+)
+
+// The functions of this file translate the sdsaasv2 models to their sdsaasv1 counterparts. They return nil for nil.
+
+// convertAll translates a slice of models.
+func convertAll[S any, T any](items []S, convert func(*S) *T) []T {
+	if items == nil {
+		return nil
+	}
+	converted := make([]T, len(items))
+	for i := range items {
+		converted[i] = *convert(&items[i])
+	}
+	return converted
+}
+
+// stringPtr translates an enumerated value, which sdsaasv1 represents as a string.
+func stringPtr[T ~string](value *T) *string {
+	if value == nil {
+		return nil
+	}
+	converted := string(*value)
+	return &converted
+}
+
+func pageLink(link *sdsaasv2.PageLink) *sdsaasv1.PageLink {
+	if link == nil {
+		return nil
+	}
+	return &sdsaasv1.PageLink{Href: link.Href}
+}
+
+func volume(volume *sdsaasv2.Volume) *sdsaasv1.Volume {
+	if volume == nil {
+		return nil
+	}
+	return &sdsaasv1.Volume{
+		Bandwidth:      volume.Bandwidth,
+		Capacity:       volume.Capacity,
+		CreatedAt:      volume.CreatedAt,
+		Href:           volume.Href,
+		ID:             volume.ID,
+		Iops:           volume.Iops,
+		Name:           volume.Name,
+		ResourceType:   volume.ResourceType,
+		Status:         stringPtr(volume.Status),
+		StatusReasons:  convertAll(volume.StatusReasons, volumeStatusReason),
+		VolumeMappings: convertAll(volume.VolumeMappings, volumeMapping),
+		SnapshotCount:  volume.SnapshotCount,
+		SourceSnapshot: sourceSnapshot(volume.SourceSnapshot),
+	}
+}
+
+// volumeSummary translates the result of CreateVolume, which lacks the volume mappings and snapshots of a volume.
+func volumeSummary(volume *sdsaasv2.VolumeSummary) *sdsaasv1.Volume {
+	if volume == nil {
+		return nil
+	}
+	return &sdsaasv1.Volume{
+		Bandwidth:     volume.Bandwidth,
+		Capacity:      volume.Capacity,
+		CreatedAt:     volume.CreatedAt,
+		Href:          volume.Href,
+		ID:            volume.ID,
+		Iops:          volume.Iops,
+		Name:          volume.Name,
+		ResourceType:  volume.ResourceType,
+		Status:        stringPtr(volume.Status),
+		StatusReasons: convertAll(volume.StatusReasons, volumeStatusReason),
+	}
+}
+
+func volumeCollection(collection *sdsaasv2.VolumeCollection) *sdsaasv1.VolumeCollection {
+	if collection == nil {
+		return nil
+	}
+	return &sdsaasv1.VolumeCollection{
+		First:      pageLink(collection.First),
+		Limit:      collection.Limit,
+		Next:       pageLink(collection.Next),
+		TotalCount: collection.TotalCount,
+		Volumes:    convertAll(collection.Volumes, volume),
+	}
+}
+
+func volumeStatusReason(reason *sdsaasv2.VolumeStatusReason) *sdsaasv1.VolumeStatusReason {
+	return &sdsaasv1.VolumeStatusReason{Code: reason.Code, Message: reason.Message, MoreInfo: reason.MoreInfo}
+}
+
+func sourceSnapshot(snapshot *sdsaasv2.SourceSnapshot) *sdsaasv1.SourceSnapshot {
+	if snapshot == nil {
+		return nil
+	}
+	return &sdsaasv1.SourceSnapshot{ID: snapshot.ID}
+}
+
+func volumeReference(reference *sdsaasv2.VolumeReference) *sdsaasv1.VolumeReference {
+	if reference == nil {
+		return nil
+	}
+	return &sdsaasv1.VolumeReference{ID: reference.ID, Name: reference.Name}
+}
+
+func hostReference(reference *sdsaasv2.HostReference) *sdsaasv1.HostReference {
+	if reference == nil {
+		return nil
+	}
+	return &sdsaasv1.HostReference{ID: reference.ID, Name: reference.Name, Nqn: reference.Nqn}
+}
+
+func gateway(gateway *sdsaasv2.Gateway) *sdsaasv1.Gateway {
+	return &sdsaasv1.Gateway{IPAddress: gateway.IPAddress, Port: gateway.Port}
+}
+
+// volumeMapping translates a volume mapping, and sets the StorageIdentifier of sdsaasv1, which gathers the
+// subsystem NQN, namespace and gateways that sdsaasv2 returns as separate properties.
+func volumeMapping(mapping *sdsaasv2.VolumeMapping) *sdsaasv1.VolumeMapping {
+	if mapping == nil {
+		return nil
+	}
+	converted := &sdsaasv1.VolumeMapping{
+		Status:       stringPtr(mapping.Status),
+		Href:         mapping.Href,
+		ID:           mapping.ID,
+		Volume:       volumeReference(mapping.Volume),
+		Host:         hostReference(mapping.Host),
+		SubsystemNqn: mapping.SubsystemNqn,
+		Gateways:     convertAll(mapping.Gateways, gateway),
+	}
+	if mapping.Namespace != nil {
+		converted.Namespace = &sdsaasv1.Namespace{ID: mapping.Namespace.ID, UUID: mapping.Namespace.UUID}
+	}
+	if mapping.SubsystemNqn != nil || mapping.Namespace != nil {
+		converted.StorageIdentifier = &sdsaasv1.StorageIdentifier{
+			SubsystemNqn: mapping.SubsystemNqn,
+			Gateways:     converted.Gateways,
+		}
+		if mapping.Namespace != nil {
+			converted.StorageIdentifier.NamespaceID = mapping.Namespace.ID
+			converted.StorageIdentifier.NamespaceUUID = mapping.Namespace.UUID
+		}
+	}
+	return converted
+}
+
+// volumeMappingReference translates the result of CreateVolumeMapping, which lacks the storage identifier of a
+// volume mapping.
+func volumeMappingReference(mapping *sdsaasv2.VolumeMappingReference) *sdsaasv1.VolumeMapping {
+	if mapping == nil {
+		return nil
+	}
+	return &sdsaasv1.VolumeMapping{
+		Status: stringPtr(mapping.Status),
+		Href:   mapping.Href,
+		ID:     mapping.ID,
+		Volume: volumeReference(mapping.Volume),
+		Host:   hostReference(mapping.Host),
+	}
+}
+
+func volumeMappingCollection(collection *sdsaasv2.VolumeMappingCollection) *sdsaasv1.VolumeMappingCollection {
+	if collection == nil {
+		return nil
+	}
+	return &sdsaasv1.VolumeMappingCollection{
+		First:          pageLink(collection.First),
+		VolumeMappings: convertAll(collection.VolumeMappings, volumeMapping),
+		Limit:          collection.Limit,
+		Next:           pageLink(collection.Next),
+		TotalCount:     collection.TotalCount,
+	}
+}
+
+func host(host *sdsaasv2.Host) *sdsaasv1.Host {
+	if host == nil {
+		return nil
+	}
+	return &sdsaasv1.Host{
+		CreatedAt:      host.CreatedAt,
+		Href:           host.Href,
+		ID:             host.ID,
+		Name:           host.Name,
+		Nqn:            host.Nqn,
+		PskEnabled:     host.PskEnabled,
+		VolumeMappings: convertAll(host.VolumeMappings, volumeMapping),
+	}
+}
+
+// hostSummary translates the result of CreateHost, whose volume mappings lack their storage identifiers.
+func hostSummary(host *sdsaasv2.HostSummary) *sdsaasv1.Host {
+	if host == nil {
+		return nil
+	}
+	return &sdsaasv1.Host{
+		CreatedAt:      host.CreatedAt,
+		Href:           host.Href,
+		ID:             host.ID,
+		Name:           host.Name,
+		Nqn:            host.Nqn,
+		PskEnabled:     host.PskEnabled,
+		VolumeMappings: convertAll(host.VolumeMappings, volumeMappingReference),
+	}
+}
+
+func hostCollection(collection *sdsaasv2.HostCollection) *sdsaasv1.HostCollection {
+	if collection == nil {
+		return nil
+	}
+	return &sdsaasv1.HostCollection{
+		First:      pageLink(collection.First),
+		Hosts:      convertAll(collection.Hosts, host),
+		Limit:      collection.Limit,
+		Next:       pageLink(collection.Next),
+		TotalCount: collection.TotalCount,
+	}
+}
+
+func snapshot(snapshot *sdsaasv2.Snapshot) *sdsaasv1.Snapshot {
+	if snapshot == nil {
+		return nil
+	}
+	converted := &sdsaasv1.Snapshot{
+		ID:              snapshot.ID,
+		Href:            snapshot.Href,
+		Name:            snapshot.Name,
+		CreatedAt:       snapshot.CreatedAt,
+		ResourceType:    snapshot.ResourceType,
+		LifecycleState:  snapshot.LifecycleState,
+		Size:            snapshot.Size,
+		MinimumCapacity: snapshot.MinimumCapacity,
+		Deletable:       snapshot.Deletable,
+	}
+	if snapshot.SourceVolume != nil {
+		converted.SourceVolume = &sdsaasv1.SourceVolume{
+			ID:           snapshot.SourceVolume.ID,
+			Name:         snapshot.SourceVolume.Name,
+			ResourceType: snapshot.SourceVolume.ResourceType,
+		}
+	}
+	return converted
+}
+
+func snapshotCollection(collection *sdsaasv2.SnapshotCollection) *sdsaasv1.SnapshotCollection {
+	if collection == nil {
+		return nil
+	}
+	return &sdsaasv1.SnapshotCollection{
+		Snapshots:  convertAll(collection.Snapshots, snapshot),
+		First:      pageLink(collection.First),
+		Limit:      collection.Limit,
+		Next:       pageLink(collection.Next),
+		TotalCount: collection.TotalCount,
+	}
+}
+
+func credentialsFound(credentials *sdsaasv2.StorageCredResponse) *sdsaasv1.CredentialsFound {
+	if credentials == nil {
+		return nil
+	}
+	return &sdsaasv1.CredentialsFound{S3Credentials: credentials.S3Credentials}
+}
+
+func credentialsUpdated(credentials *sdsaasv2.AccessKeyResponse) *sdsaasv1.CredentialsUpdated {
+	if credentials == nil {
+		return nil
+	}
+	return &sdsaasv1.CredentialsUpdated{AccessKey: credentials.AccessKey, SecretKey: credentials.SecretKey}
+}
+
+func certificateList(certificates *sdsaasv2.CertListResponse) *sdsaasv1.CertificateList {
+	if certificates == nil {
+		return nil
+	}
+	return &sdsaasv1.CertificateList{Certificates: certificates.Certificates}
+}
+
+func certificateFound(status *sdsaasv2.StatusResponse) *sdsaasv1.CertificateFound {
+	if status == nil {
+		return nil
+	}
+	return &sdsaasv1.CertificateFound{Name: status.Name, ExpirationDate: status.ExpirationDate, Expired: status.Expired}
+}
+
+// certificateUpdated translates the result of uploading a certificate. The ErrorObject list of sdsaasv2 becomes the
+// list of "code", "message" and "more_info" maps of sdsaasv1.
+func certificateUpdated(certificate *sdsaasv2.CertResponse) *sdsaasv1.CertificateUpdated {
+	if certificate == nil {
+		return nil
+	}
+	converted := &sdsaasv1.CertificateUpdated{
+		Name:             certificate.Name,
+		Trace:            certificate.Trace,
+		Errors:           make([]map[string]string, len(certificate.Errors)),
+		ValidCertificate: certificate.ValidCertificate,
+		ValidKey:         certificate.ValidKey,
+	}
+	for i, errorObject := range certificate.Errors {
+		converted.Errors[i] = map[string]string{}
+		for key, value := range map[string]*string{"code": errorObject.Code, "message": errorObject.Message, "more_info": errorObject.MoreInfo} {
+			if value != nil {
+				converted.Errors[i][key] = *value
+			}
+		}
+	}
+	return converted
+}
+
+func volumeMappingPrototype(prototype *sdsaasv1.VolumeMappingPrototype) *sdsaasv2.VolumeMappingPrototype {
+	converted := &sdsaasv2.VolumeMappingPrototype{}
+	if prototype.Volume != nil {
+		converted.Volume = &sdsaasv2.VolumeIdentity{ID: prototype.Volume.ID}
+	}
+	return converted
+}
@@ -0,0 +1,806 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package sdsaasv2fake provides Fake, a test double of the SdsaasV2 service for code written against the
+// interfaces of the sdsaasv2 package, such as sdsaasv2.SdsaasV2API and sdsaasv2.VolumesAPI.
+package sdsaasv2fake
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+)
+
+// ErrNotStubbed is returned, wrapped, by the operations of a Fake that have not been programmed.
+var ErrNotStubbed = errors.New("operation not stubbed")
+
+// Call : A call of an operation of a Fake.
+type Call struct {
+	// The operation ID, e.g. "ListVolumes".
+	Operation string
+
+	// The context of the call; context.Background() for the methods without a context.
+	Context context.Context
+
+	// The options of the call, e.g. *sdsaasv2.ListVolumesOptions.
+	Options interface{}
+}
+
+// Fake : An implementation of sdsaasv2.SdsaasV2API for tests. It records every call, and answers each operation
+// with its stub, e.g. ListVolumesStub, which can be set directly or with e.g. ListVolumesReturns. An operation
+// without a stub fails with an error that matches ErrNotStubbed.
+//
+// The stubs must not be set directly while the fake is in use by other goroutines.
+type Fake struct {
+	// ListVolumesStub answers ListVolumes and ListVolumesWithContext.
+	ListVolumesStub func(ctx context.Context, listVolumesOptions *sdsaasv2.ListVolumesOptions) (*sdsaasv2.VolumeCollection, *core.DetailedResponse, error)
+
+	// CreateVolumeStub answers CreateVolume and CreateVolumeWithContext.
+	CreateVolumeStub func(ctx context.Context, createVolumeOptions *sdsaasv2.CreateVolumeOptions) (*sdsaasv2.VolumeSummary, *core.DetailedResponse, error)
+
+	// DeleteVolumeStub answers DeleteVolume and DeleteVolumeWithContext.
+	DeleteVolumeStub func(ctx context.Context, deleteVolumeOptions *sdsaasv2.DeleteVolumeOptions) (*core.DetailedResponse, error)
+
+	// GetVolumeStub answers GetVolume and GetVolumeWithContext.
+	GetVolumeStub func(ctx context.Context, getVolumeOptions *sdsaasv2.GetVolumeOptions) (*sdsaasv2.Volume, *core.DetailedResponse, error)
+
+	// UpdateVolumeStub answers UpdateVolume and UpdateVolumeWithContext.
+	UpdateVolumeStub func(ctx context.Context, updateVolumeOptions *sdsaasv2.UpdateVolumeOptions) (*sdsaasv2.Volume, *core.DetailedResponse, error)
+
+	// ListHostsStub answers ListHosts and ListHostsWithContext.
+	ListHostsStub func(ctx context.Context, listHostsOptions *sdsaasv2.ListHostsOptions) (*sdsaasv2.HostCollection, *core.DetailedResponse, error)
+
+	// CreateHostStub answers CreateHost and CreateHostWithContext.
+	CreateHostStub func(ctx context.Context, createHostOptions *sdsaasv2.CreateHostOptions) (*sdsaasv2.HostSummary, *core.DetailedResponse, error)
+
+	// DeleteHostStub answers DeleteHost and DeleteHostWithContext.
+	DeleteHostStub func(ctx context.Context, deleteHostOptions *sdsaasv2.DeleteHostOptions) (*core.DetailedResponse, error)
+
+	// GetHostStub answers GetHost and GetHostWithContext.
+	GetHostStub func(ctx context.Context, getHostOptions *sdsaasv2.GetHostOptions) (*sdsaasv2.Host, *core.DetailedResponse, error)
+
+	// UpdateHostStub answers UpdateHost and UpdateHostWithContext.
+	UpdateHostStub func(ctx context.Context, updateHostOptions *sdsaasv2.UpdateHostOptions) (*sdsaasv2.Host, *core.DetailedResponse, error)
+
+	// DeleteVolumeMappingsStub answers DeleteVolumeMappings and DeleteVolumeMappingsWithContext.
+	DeleteVolumeMappingsStub func(ctx context.Context, deleteVolumeMappingsOptions *sdsaasv2.DeleteVolumeMappingsOptions) (*core.DetailedResponse, error)
+
+	// ListVolumeMappingsStub answers ListVolumeMappings and ListVolumeMappingsWithContext.
+	ListVolumeMappingsStub func(ctx context.Context, listVolumeMappingsOptions *sdsaasv2.ListVolumeMappingsOptions) (*sdsaasv2.VolumeMappingCollection, *core.DetailedResponse, error)
+
+	// CreateVolumeMappingStub answers CreateVolumeMapping and CreateVolumeMappingWithContext.
+	CreateVolumeMappingStub func(ctx context.Context, createVolumeMappingOptions *sdsaasv2.CreateVolumeMappingOptions) (*sdsaasv2.VolumeMappingReference, *core.DetailedResponse, error)
+
+	// DeleteVolumeMappingStub answers DeleteVolumeMapping and DeleteVolumeMappingWithContext.
+	DeleteVolumeMappingStub func(ctx context.Context, deleteVolumeMappingOptions *sdsaasv2.DeleteVolumeMappingOptions) (*core.DetailedResponse, error)
+
+	// GetVolumeMappingStub answers GetVolumeMapping and GetVolumeMappingWithContext.
+	GetVolumeMappingStub func(ctx context.Context, getVolumeMappingOptions *sdsaasv2.GetVolumeMappingOptions) (*sdsaasv2.VolumeMapping, *core.DetailedResponse, error)
+
+	// DeleteSnapshotsStub answers DeleteSnapshots and DeleteSnapshotsWithContext.
+	DeleteSnapshotsStub func(ctx context.Context, deleteSnapshotsOptions *sdsaasv2.DeleteSnapshotsOptions) (*core.DetailedResponse, error)
+
+	// ListSnapshotsStub answers ListSnapshots and ListSnapshotsWithContext.
+	ListSnapshotsStub func(ctx context.Context, listSnapshotsOptions *sdsaasv2.ListSnapshotsOptions) (*sdsaasv2.SnapshotCollection, *core.DetailedResponse, error)
+
+	// CreateSnapshotStub answers CreateSnapshot and CreateSnapshotWithContext.
+	CreateSnapshotStub func(ctx context.Context, createSnapshotOptions *sdsaasv2.CreateSnapshotOptions) (*sdsaasv2.Snapshot, *core.DetailedResponse, error)
+
+	// DeleteSnapshotStub answers DeleteSnapshot and DeleteSnapshotWithContext.
+	DeleteSnapshotStub func(ctx context.Context, deleteSnapshotOptions *sdsaasv2.DeleteSnapshotOptions) (*core.DetailedResponse, error)
+
+	// GetSnapshotStub answers GetSnapshot and GetSnapshotWithContext.
+	GetSnapshotStub func(ctx context.Context, getSnapshotOptions *sdsaasv2.GetSnapshotOptions) (*sdsaasv2.Snapshot, *core.DetailedResponse, error)
+
+	// UpdateSnapshotStub answers UpdateSnapshot and UpdateSnapshotWithContext.
+	UpdateSnapshotStub func(ctx context.Context, updateSnapshotOptions *sdsaasv2.UpdateSnapshotOptions) (*sdsaasv2.Snapshot, *core.DetailedResponse, error)
+
+	// ListHmacCredentialsStub answers ListHmacCredentials and ListHmacCredentialsWithContext.
+	ListHmacCredentialsStub func(ctx context.Context, listHmacCredentialsOptions *sdsaasv2.ListHmacCredentialsOptions) (*sdsaasv2.StorageCredResponse, *core.DetailedResponse, error)
+
+	// DeleteHmacCredentialsStub answers DeleteHmacCredentials and DeleteHmacCredentialsWithContext.
+	DeleteHmacCredentialsStub func(ctx context.Context, deleteHmacCredentialsOptions *sdsaasv2.DeleteHmacCredentialsOptions) (*core.DetailedResponse, error)
+
+	// CreateHmacCredentialsStub answers CreateHmacCredentials and CreateHmacCredentialsWithContext.
+	CreateHmacCredentialsStub func(ctx context.Context, createHmacCredentialsOptions *sdsaasv2.CreateHmacCredentialsOptions) (*sdsaasv2.AccessKeyResponse, *core.DetailedResponse, error)
+
+	// ListCertificatesStub answers ListCertificates and ListCertificatesWithContext.
+	ListCertificatesStub func(ctx context.Context, listCertificatesOptions *sdsaasv2.ListCertificatesOptions) (*sdsaasv2.CertListResponse, *core.DetailedResponse, error)
+
+	// DeleteSslCertStub answers DeleteSslCert and DeleteSslCertWithContext.
+	DeleteSslCertStub func(ctx context.Context, deleteSslCertOptions *sdsaasv2.DeleteSslCertOptions) (*core.DetailedResponse, error)
+
+	// GetS3SslCertStatusStub answers GetS3SslCertStatus and GetS3SslCertStatusWithContext.
+	GetS3SslCertStatusStub func(ctx context.Context, getS3SslCertStatusOptions *sdsaasv2.GetS3SslCertStatusOptions) (*sdsaasv2.StatusResponse, *core.DetailedResponse, error)
+
+	// CreateSslCertStub answers CreateSslCert and CreateSslCertWithContext.
+	CreateSslCertStub func(ctx context.Context, createSslCertOptions *sdsaasv2.CreateSslCertOptions) (*sdsaasv2.CertResponse, *core.DetailedResponse, error)
+
+	// ReplaceSslCertStub answers ReplaceSslCert and ReplaceSslCertWithContext.
+	ReplaceSslCertStub func(ctx context.Context, replaceSslCertOptions *sdsaasv2.ReplaceSslCertOptions) (*sdsaasv2.CertResponse, *core.DetailedResponse, error)
+
+	mutex sync.Mutex
+	calls []Call
+}
+
+// The fake implements all the operations.
+var _ sdsaasv2.SdsaasV2API = (*Fake)(nil)
+
+// NewFake : constructs a Fake without stubs.
+func NewFake() *Fake {
+	return &Fake{}
+}
+
+// Calls returns the calls of every operation, oldest first.
+func (fake *Fake) Calls() []Call {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	return append([]Call(nil), fake.calls...)
+}
+
+// CallsOf returns the calls of an operation, oldest first.
+func (fake *Fake) CallsOf(operation string) (calls []Call) {
+	for _, call := range fake.Calls() {
+		if call.Operation == operation {
+			calls = append(calls, call)
+		}
+	}
+	return
+}
+
+// CallCount returns the number of calls of an operation.
+func (fake *Fake) CallCount(operation string) int {
+	return len(fake.CallsOf(operation))
+}
+
+// Reset forgets the recorded calls. The stubs are kept.
+func (fake *Fake) Reset() {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.calls = nil
+}
+
+// record records a call and returns the stub of the operation, read while holding the lock.
+func record[S any](fake *Fake, operation string, ctx context.Context, options interface{}, stub *S) S {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	fake.calls = append(fake.calls, Call{Operation: operation, Context: ctx, Options: options})
+	return *stub
+}
+
+// setStub sets the stub of an operation while holding the lock.
+func setStub[S any](fake *Fake, stub *S, value S) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+	*stub = value
+}
+
+func notStubbed(operation string) error {
+	return fmt.Errorf("%w: %s", ErrNotStubbed, operation)
+}
+
+// ListVolumes records the call and answers it with ListVolumesStub.
+func (fake *Fake) ListVolumes(listVolumesOptions *sdsaasv2.ListVolumesOptions) (result *sdsaasv2.VolumeCollection, response *core.DetailedResponse, err error) {
+	return fake.ListVolumesWithContext(context.Background(), listVolumesOptions)
+}
+
+// ListVolumesWithContext records the call and answers it with ListVolumesStub.
+func (fake *Fake) ListVolumesWithContext(ctx context.Context, listVolumesOptions *sdsaasv2.ListVolumesOptions) (result *sdsaasv2.VolumeCollection, response *core.DetailedResponse, err error) {
+	stub := record(fake, "ListVolumes", ctx, listVolumesOptions, &fake.ListVolumesStub)
+	if stub == nil {
+		return nil, nil, notStubbed("ListVolumes")
+	}
+	return stub(ctx, listVolumesOptions)
+}
+
+// ListVolumesReturns programs ListVolumes to return the values.
+func (fake *Fake) ListVolumesReturns(result *sdsaasv2.VolumeCollection, response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.ListVolumesStub, func(context.Context, *sdsaasv2.ListVolumesOptions) (*sdsaasv2.VolumeCollection, *core.DetailedResponse, error) {
+		return result, response, err
+	})
+}
+
+// CreateVolume records the call and answers it with CreateVolumeStub.
+func (fake *Fake) CreateVolume(createVolumeOptions *sdsaasv2.CreateVolumeOptions) (result *sdsaasv2.VolumeSummary, response *core.DetailedResponse, err error) {
+	return fake.CreateVolumeWithContext(context.Background(), createVolumeOptions)
+}
+
+// CreateVolumeWithContext records the call and answers it with CreateVolumeStub.
+func (fake *Fake) CreateVolumeWithContext(ctx context.Context, createVolumeOptions *sdsaasv2.CreateVolumeOptions) (result *sdsaasv2.VolumeSummary, response *core.DetailedResponse, err error) {
+	stub := record(fake, "CreateVolume", ctx, createVolumeOptions, &fake.CreateVolumeStub)
+	if stub == nil {
+		return nil, nil, notStubbed("CreateVolume")
+	}
+	return stub(ctx, createVolumeOptions)
+}
+
+// CreateVolumeReturns programs CreateVolume to return the values.
+func (fake *Fake) CreateVolumeReturns(result *sdsaasv2.VolumeSummary, response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.CreateVolumeStub, func(context.Context, *sdsaasv2.CreateVolumeOptions) (*sdsaasv2.VolumeSummary, *core.DetailedResponse, error) {
+		return result, response, err
+	})
+}
+
+// DeleteVolume records the call and answers it with DeleteVolumeStub.
+func (fake *Fake) DeleteVolume(deleteVolumeOptions *sdsaasv2.DeleteVolumeOptions) (response *core.DetailedResponse, err error) {
+	return fake.DeleteVolumeWithContext(context.Background(), deleteVolumeOptions)
+}
+
+// DeleteVolumeWithContext records the call and answers it with DeleteVolumeStub.
+func (fake *Fake) DeleteVolumeWithContext(ctx context.Context, deleteVolumeOptions *sdsaasv2.DeleteVolumeOptions) (response *core.DetailedResponse, err error) {
+	stub := record(fake, "DeleteVolume", ctx, deleteVolumeOptions, &fake.DeleteVolumeStub)
+	if stub == nil {
+		return nil, notStubbed("DeleteVolume")
+	}
+	return stub(ctx, deleteVolumeOptions)
+}
+
+// DeleteVolumeReturns programs DeleteVolume to return the values.
+func (fake *Fake) DeleteVolumeReturns(response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.DeleteVolumeStub, func(context.Context, *sdsaasv2.DeleteVolumeOptions) (*core.DetailedResponse, error) {
+		return response, err
+	})
+}
+
+// GetVolume records the call and answers it with GetVolumeStub.
+func (fake *Fake) GetVolume(getVolumeOptions *sdsaasv2.GetVolumeOptions) (result *sdsaasv2.Volume, response *core.DetailedResponse, err error) {
+	return fake.GetVolumeWithContext(context.Background(), getVolumeOptions)
+}
+
+// GetVolumeWithContext records the call and answers it with GetVolumeStub.
+func (fake *Fake) GetVolumeWithContext(ctx context.Context, getVolumeOptions *sdsaasv2.GetVolumeOptions) (result *sdsaasv2.Volume, response *core.DetailedResponse, err error) {
+	stub := record(fake, "GetVolume", ctx, getVolumeOptions, &fake.GetVolumeStub)
+	if stub == nil {
+		return nil, nil, notStubbed("GetVolume")
+	}
+	return stub(ctx, getVolumeOptions)
+}
+
+// GetVolumeReturns programs GetVolume to return the values.
+func (fake *Fake) GetVolumeReturns(result *sdsaasv2.Volume, response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.GetVolumeStub, func(context.Context, *sdsaasv2.GetVolumeOptions) (*sdsaasv2.Volume, *core.DetailedResponse, error) {
+		return result, response, err
+	})
+}
+
+// UpdateVolume records the call and answers it with UpdateVolumeStub.
+func (fake *Fake) UpdateVolume(updateVolumeOptions *sdsaasv2.UpdateVolumeOptions) (result *sdsaasv2.Volume, response *core.DetailedResponse, err error) {
+	return fake.UpdateVolumeWithContext(context.Background(), updateVolumeOptions)
+}
+
+// UpdateVolumeWithContext records the call and answers it with UpdateVolumeStub.
+func (fake *Fake) UpdateVolumeWithContext(ctx context.Context, updateVolumeOptions *sdsaasv2.UpdateVolumeOptions) (result *sdsaasv2.Volume, response *core.DetailedResponse, err error) {
+	stub := record(fake, "UpdateVolume", ctx, updateVolumeOptions, &fake.UpdateVolumeStub)
+	if stub == nil {
+		return nil, nil, notStubbed("UpdateVolume")
+	}
+	return stub(ctx, updateVolumeOptions)
+}
+
+// UpdateVolumeReturns programs UpdateVolume to return the values.
+func (fake *Fake) UpdateVolumeReturns(result *sdsaasv2.Volume, response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.UpdateVolumeStub, func(context.Context, *sdsaasv2.UpdateVolumeOptions) (*sdsaasv2.Volume, *core.DetailedResponse, error) {
+		return result, response, err
+	})
+}
+
+// ListHosts records the call and answers it with ListHostsStub.
+func (fake *Fake) ListHosts(listHostsOptions *sdsaasv2.ListHostsOptions) (result *sdsaasv2.HostCollection, response *core.DetailedResponse, err error) {
+	return fake.ListHostsWithContext(context.Background(), listHostsOptions)
+}
+
+// ListHostsWithContext records the call and answers it with ListHostsStub.
+func (fake *Fake) ListHostsWithContext(ctx context.Context, listHostsOptions *sdsaasv2.ListHostsOptions) (result *sdsaasv2.HostCollection, response *core.DetailedResponse, err error) {
+	stub := record(fake, "ListHosts", ctx, listHostsOptions, &fake.ListHostsStub)
+	if stub == nil {
+		return nil, nil, notStubbed("ListHosts")
+	}
+	return stub(ctx, listHostsOptions)
+}
+
+// ListHostsReturns programs ListHosts to return the values.
+func (fake *Fake) ListHostsReturns(result *sdsaasv2.HostCollection, response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.ListHostsStub, func(context.Context, *sdsaasv2.ListHostsOptions) (*sdsaasv2.HostCollection, *core.DetailedResponse, error) {
+		return result, response, err
+	})
+}
+
+// CreateHost records the call and answers it with CreateHostStub.
+func (fake *Fake) CreateHost(createHostOptions *sdsaasv2.CreateHostOptions) (result *sdsaasv2.HostSummary, response *core.DetailedResponse, err error) {
+	return fake.CreateHostWithContext(context.Background(), createHostOptions)
+}
+
+// CreateHostWithContext records the call and answers it with CreateHostStub.
+func (fake *Fake) CreateHostWithContext(ctx context.Context, createHostOptions *sdsaasv2.CreateHostOptions) (result *sdsaasv2.HostSummary, response *core.DetailedResponse, err error) {
+	stub := record(fake, "CreateHost", ctx, createHostOptions, &fake.CreateHostStub)
+	if stub == nil {
+		return nil, nil, notStubbed("CreateHost")
+	}
+	return stub(ctx, createHostOptions)
+}
+
+// CreateHostReturns programs CreateHost to return the values.
+func (fake *Fake) CreateHostReturns(result *sdsaasv2.HostSummary, response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.CreateHostStub, func(context.Context, *sdsaasv2.CreateHostOptions) (*sdsaasv2.HostSummary, *core.DetailedResponse, error) {
+		return result, response, err
+	})
+}
+
+// DeleteHost records the call and answers it with DeleteHostStub.
+func (fake *Fake) DeleteHost(deleteHostOptions *sdsaasv2.DeleteHostOptions) (response *core.DetailedResponse, err error) {
+	return fake.DeleteHostWithContext(context.Background(), deleteHostOptions)
+}
+
+// DeleteHostWithContext records the call and answers it with DeleteHostStub.
+func (fake *Fake) DeleteHostWithContext(ctx context.Context, deleteHostOptions *sdsaasv2.DeleteHostOptions) (response *core.DetailedResponse, err error) {
+	stub := record(fake, "DeleteHost", ctx, deleteHostOptions, &fake.DeleteHostStub)
+	if stub == nil {
+		return nil, notStubbed("DeleteHost")
+	}
+	return stub(ctx, deleteHostOptions)
+}
+
+// DeleteHostReturns programs DeleteHost to return the values.
+func (fake *Fake) DeleteHostReturns(response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.DeleteHostStub, func(context.Context, *sdsaasv2.DeleteHostOptions) (*core.DetailedResponse, error) {
+		return response, err
+	})
+}
+
+// GetHost records the call and answers it with GetHostStub.
+func (fake *Fake) GetHost(getHostOptions *sdsaasv2.GetHostOptions) (result *sdsaasv2.Host, response *core.DetailedResponse, err error) {
+	return fake.GetHostWithContext(context.Background(), getHostOptions)
+}
+
+// GetHostWithContext records the call and answers it with GetHostStub.
+func (fake *Fake) GetHostWithContext(ctx context.Context, getHostOptions *sdsaasv2.GetHostOptions) (result *sdsaasv2.Host, response *core.DetailedResponse, err error) {
+	stub := record(fake, "GetHost", ctx, getHostOptions, &fake.GetHostStub)
+	if stub == nil {
+		return nil, nil, notStubbed("GetHost")
+	}
+	return stub(ctx, getHostOptions)
+}
+
+// GetHostReturns programs GetHost to return the values.
+func (fake *Fake) GetHostReturns(result *sdsaasv2.Host, response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.GetHostStub, func(context.Context, *sdsaasv2.GetHostOptions) (*sdsaasv2.Host, *core.DetailedResponse, error) {
+		return result, response, err
+	})
+}
+
+// UpdateHost records the call and answers it with UpdateHostStub.
+func (fake *Fake) UpdateHost(updateHostOptions *sdsaasv2.UpdateHostOptions) (result *sdsaasv2.Host, response *core.DetailedResponse, err error) {
+	return fake.UpdateHostWithContext(context.Background(), updateHostOptions)
+}
+
+// UpdateHostWithContext records the call and answers it with UpdateHostStub.
+func (fake *Fake) UpdateHostWithContext(ctx context.Context, updateHostOptions *sdsaasv2.UpdateHostOptions) (result *sdsaasv2.Host, response *core.DetailedResponse, err error) {
+	stub := record(fake, "UpdateHost", ctx, updateHostOptions, &fake.UpdateHostStub)
+	if stub == nil {
+		return nil, nil, notStubbed("UpdateHost")
+	}
+	return stub(ctx, updateHostOptions)
+}
+
+// UpdateHostReturns programs UpdateHost to return the values.
+func (fake *Fake) UpdateHostReturns(result *sdsaasv2.Host, response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.UpdateHostStub, func(context.Context, *sdsaasv2.UpdateHostOptions) (*sdsaasv2.Host, *core.DetailedResponse, error) {
+		return result, response, err
+	})
+}
+
+// DeleteVolumeMappings records the call and answers it with DeleteVolumeMappingsStub.
+func (fake *Fake) DeleteVolumeMappings(deleteVolumeMappingsOptions *sdsaasv2.DeleteVolumeMappingsOptions) (response *core.DetailedResponse, err error) {
+	return fake.DeleteVolumeMappingsWithContext(context.Background(), deleteVolumeMappingsOptions)
+}
+
+// DeleteVolumeMappingsWithContext records the call and answers it with DeleteVolumeMappingsStub.
+func (fake *Fake) DeleteVolumeMappingsWithContext(ctx context.Context, deleteVolumeMappingsOptions *sdsaasv2.DeleteVolumeMappingsOptions) (response *core.DetailedResponse, err error) {
+	stub := record(fake, "DeleteVolumeMappings", ctx, deleteVolumeMappingsOptions, &fake.DeleteVolumeMappingsStub)
+	if stub == nil {
+		return nil, notStubbed("DeleteVolumeMappings")
+	}
+	return stub(ctx, deleteVolumeMappingsOptions)
+}
+
+// DeleteVolumeMappingsReturns programs DeleteVolumeMappings to return the values.
+func (fake *Fake) DeleteVolumeMappingsReturns(response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.DeleteVolumeMappingsStub, func(context.Context, *sdsaasv2.DeleteVolumeMappingsOptions) (*core.DetailedResponse, error) {
+		return response, err
+	})
+}
+
+// ListVolumeMappings records the call and answers it with ListVolumeMappingsStub.
+func (fake *Fake) ListVolumeMappings(listVolumeMappingsOptions *sdsaasv2.ListVolumeMappingsOptions) (result *sdsaasv2.VolumeMappingCollection, response *core.DetailedResponse, err error) {
+	return fake.ListVolumeMappingsWithContext(context.Background(), listVolumeMappingsOptions)
+}
+
+// ListVolumeMappingsWithContext records the call and answers it with ListVolumeMappingsStub.
+func (fake *Fake) ListVolumeMappingsWithContext(ctx context.Context, listVolumeMappingsOptions *sdsaasv2.ListVolumeMappingsOptions) (result *sdsaasv2.VolumeMappingCollection, response *core.DetailedResponse, err error) {
+	stub := record(fake, "ListVolumeMappings", ctx, listVolumeMappingsOptions, &fake.ListVolumeMappingsStub)
+	if stub == nil {
+		return nil, nil, notStubbed("ListVolumeMappings")
+	}
+	return stub(ctx, listVolumeMappingsOptions)
+}
+
+// ListVolumeMappingsReturns programs ListVolumeMappings to return the values.
+func (fake *Fake) ListVolumeMappingsReturns(result *sdsaasv2.VolumeMappingCollection, response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.ListVolumeMappingsStub, func(context.Context, *sdsaasv2.ListVolumeMappingsOptions) (*sdsaasv2.VolumeMappingCollection, *core.DetailedResponse, error) {
+		return result, response, err
+	})
+}
+
+// CreateVolumeMapping records the call and answers it with CreateVolumeMappingStub.
+func (fake *Fake) CreateVolumeMapping(createVolumeMappingOptions *sdsaasv2.CreateVolumeMappingOptions) (result *sdsaasv2.VolumeMappingReference, response *core.DetailedResponse, err error) {
+	return fake.CreateVolumeMappingWithContext(context.Background(), createVolumeMappingOptions)
+}
+
+// CreateVolumeMappingWithContext records the call and answers it with CreateVolumeMappingStub.
+func (fake *Fake) CreateVolumeMappingWithContext(ctx context.Context, createVolumeMappingOptions *sdsaasv2.CreateVolumeMappingOptions) (result *sdsaasv2.VolumeMappingReference, response *core.DetailedResponse, err error) {
+	stub := record(fake, "CreateVolumeMapping", ctx, createVolumeMappingOptions, &fake.CreateVolumeMappingStub)
+	if stub == nil {
+		return nil, nil, notStubbed("CreateVolumeMapping")
+	}
+	return stub(ctx, createVolumeMappingOptions)
+}
+
+// CreateVolumeMappingReturns programs CreateVolumeMapping to return the values.
+func (fake *Fake) CreateVolumeMappingReturns(result *sdsaasv2.VolumeMappingReference, response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.CreateVolumeMappingStub, func(context.Context, *sdsaasv2.CreateVolumeMappingOptions) (*sdsaasv2.VolumeMappingReference, *core.DetailedResponse, error) {
+		return result, response, err
+	})
+}
+
+// DeleteVolumeMapping records the call and answers it with DeleteVolumeMappingStub.
+func (fake *Fake) DeleteVolumeMapping(deleteVolumeMappingOptions *sdsaasv2.DeleteVolumeMappingOptions) (response *core.DetailedResponse, err error) {
+	return fake.DeleteVolumeMappingWithContext(context.Background(), deleteVolumeMappingOptions)
+}
+
+// DeleteVolumeMappingWithContext records the call and answers it with DeleteVolumeMappingStub.
+func (fake *Fake) DeleteVolumeMappingWithContext(ctx context.Context, deleteVolumeMappingOptions *sdsaasv2.DeleteVolumeMappingOptions) (response *core.DetailedResponse, err error) {
+	stub := record(fake, "DeleteVolumeMapping", ctx, deleteVolumeMappingOptions, &fake.DeleteVolumeMappingStub)
+	if stub == nil {
+		return nil, notStubbed("DeleteVolumeMapping")
+	}
+	return stub(ctx, deleteVolumeMappingOptions)
+}
+
+// DeleteVolumeMappingReturns programs DeleteVolumeMapping to return the values.
+func (fake *Fake) DeleteVolumeMappingReturns(response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.DeleteVolumeMappingStub, func(context.Context, *sdsaasv2.DeleteVolumeMappingOptions) (*core.DetailedResponse, error) {
+		return response, err
+	})
+}
+
+// GetVolumeMapping records the call and answers it with GetVolumeMappingStub.
+func (fake *Fake) GetVolumeMapping(getVolumeMappingOptions *sdsaasv2.GetVolumeMappingOptions) (result *sdsaasv2.VolumeMapping, response *core.DetailedResponse, err error) {
+	return fake.GetVolumeMappingWithContext(context.Background(), getVolumeMappingOptions)
+}
+
+// GetVolumeMappingWithContext records the call and answers it with GetVolumeMappingStub.
+func (fake *Fake) GetVolumeMappingWithContext(ctx context.Context, getVolumeMappingOptions *sdsaasv2.GetVolumeMappingOptions) (result *sdsaasv2.VolumeMapping, response *core.DetailedResponse, err error) {
+	stub := record(fake, "GetVolumeMapping", ctx, getVolumeMappingOptions, &fake.GetVolumeMappingStub)
+	if stub == nil {
+		return nil, nil, notStubbed("GetVolumeMapping")
+	}
+	return stub(ctx, getVolumeMappingOptions)
+}
+
+// GetVolumeMappingReturns programs GetVolumeMapping to return the values.
+func (fake *Fake) GetVolumeMappingReturns(result *sdsaasv2.VolumeMapping, response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.GetVolumeMappingStub, func(context.Context, *sdsaasv2.GetVolumeMappingOptions) (*sdsaasv2.VolumeMapping, *core.DetailedResponse, error) {
+		return result, response, err
+	})
+}
+
+// DeleteSnapshots records the call and answers it with DeleteSnapshotsStub.
+func (fake *Fake) DeleteSnapshots(deleteSnapshotsOptions *sdsaasv2.DeleteSnapshotsOptions) (response *core.DetailedResponse, err error) {
+	return fake.DeleteSnapshotsWithContext(context.Background(), deleteSnapshotsOptions)
+}
+
+// DeleteSnapshotsWithContext records the call and answers it with DeleteSnapshotsStub.
+func (fake *Fake) DeleteSnapshotsWithContext(ctx context.Context, deleteSnapshotsOptions *sdsaasv2.DeleteSnapshotsOptions) (response *core.DetailedResponse, err error) {
+	stub := record(fake, "DeleteSnapshots", ctx, deleteSnapshotsOptions, &fake.DeleteSnapshotsStub)
+	if stub == nil {
+		return nil, notStubbed("DeleteSnapshots")
+	}
+	return stub(ctx, deleteSnapshotsOptions)
+}
+
+// DeleteSnapshotsReturns programs DeleteSnapshots to return the values.
+func (fake *Fake) DeleteSnapshotsReturns(response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.DeleteSnapshotsStub, func(context.Context, *sdsaasv2.DeleteSnapshotsOptions) (*core.DetailedResponse, error) {
+		return response, err
+	})
+}
+
+// ListSnapshots records the call and answers it with ListSnapshotsStub.
+func (fake *Fake) ListSnapshots(listSnapshotsOptions *sdsaasv2.ListSnapshotsOptions) (result *sdsaasv2.SnapshotCollection, response *core.DetailedResponse, err error) {
+	return fake.ListSnapshotsWithContext(context.Background(), listSnapshotsOptions)
+}
+
+// ListSnapshotsWithContext records the call and answers it with ListSnapshotsStub.
+func (fake *Fake) ListSnapshotsWithContext(ctx context.Context, listSnapshotsOptions *sdsaasv2.ListSnapshotsOptions) (result *sdsaasv2.SnapshotCollection, response *core.DetailedResponse, err error) {
+	stub := record(fake, "ListSnapshots", ctx, listSnapshotsOptions, &fake.ListSnapshotsStub)
+	if stub == nil {
+		return nil, nil, notStubbed("ListSnapshots")
+	}
+	return stub(ctx, listSnapshotsOptions)
+}
+
+// ListSnapshotsReturns programs ListSnapshots to return the values.
+func (fake *Fake) ListSnapshotsReturns(result *sdsaasv2.SnapshotCollection, response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.ListSnapshotsStub, func(context.Context, *sdsaasv2.ListSnapshotsOptions) (*sdsaasv2.SnapshotCollection, *core.DetailedResponse, error) {
+		return result, response, err
+	})
+}
+
+// CreateSnapshot records the call and answers it with CreateSnapshotStub.
+func (fake *Fake) CreateSnapshot(createSnapshotOptions *sdsaasv2.CreateSnapshotOptions) (result *sdsaasv2.Snapshot, response *core.DetailedResponse, err error) {
+	return fake.CreateSnapshotWithContext(context.Background(), createSnapshotOptions)
+}
+
+// CreateSnapshotWithContext records the call and answers it with CreateSnapshotStub.
+func (fake *Fake) CreateSnapshotWithContext(ctx context.Context, createSnapshotOptions *sdsaasv2.CreateSnapshotOptions) (result *sdsaasv2.Snapshot, response *core.DetailedResponse, err error) {
+	stub := record(fake, "CreateSnapshot", ctx, createSnapshotOptions, &fake.CreateSnapshotStub)
+	if stub == nil {
+		return nil, nil, notStubbed("CreateSnapshot")
+	}
+	return stub(ctx, createSnapshotOptions)
+}
+
+// CreateSnapshotReturns programs CreateSnapshot to return the values.
+func (fake *Fake) CreateSnapshotReturns(result *sdsaasv2.Snapshot, response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.CreateSnapshotStub, func(context.Context, *sdsaasv2.CreateSnapshotOptions) (*sdsaasv2.Snapshot, *core.DetailedResponse, error) {
+		return result, response, err
+	})
+}
+
+// DeleteSnapshot records the call and answers it with DeleteSnapshotStub.
+func (fake *Fake) DeleteSnapshot(deleteSnapshotOptions *sdsaasv2.DeleteSnapshotOptions) (response *core.DetailedResponse, err error) {
+	return fake.DeleteSnapshotWithContext(context.Background(), deleteSnapshotOptions)
+}
+
+// DeleteSnapshotWithContext records the call and answers it with DeleteSnapshotStub.
+func (fake *Fake) DeleteSnapshotWithContext(ctx context.Context, deleteSnapshotOptions *sdsaasv2.DeleteSnapshotOptions) (response *core.DetailedResponse, err error) {
+	stub := record(fake, "DeleteSnapshot", ctx, deleteSnapshotOptions, &fake.DeleteSnapshotStub)
+	if stub == nil {
+		return nil, notStubbed("DeleteSnapshot")
+	}
+	return stub(ctx, deleteSnapshotOptions)
+}
+
+// DeleteSnapshotReturns programs DeleteSnapshot to return the values.
+func (fake *Fake) DeleteSnapshotReturns(response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.DeleteSnapshotStub, func(context.Context, *sdsaasv2.DeleteSnapshotOptions) (*core.DetailedResponse, error) {
+		return response, err
+	})
+}
+
+// GetSnapshot records the call and answers it with GetSnapshotStub.
+func (fake *Fake) GetSnapshot(getSnapshotOptions *sdsaasv2.GetSnapshotOptions) (result *sdsaasv2.Snapshot, response *core.DetailedResponse, err error) {
+	return fake.GetSnapshotWithContext(context.Background(), getSnapshotOptions)
+}
+
+// GetSnapshotWithContext records the call and answers it with GetSnapshotStub.
+func (fake *Fake) GetSnapshotWithContext(ctx context.Context, getSnapshotOptions *sdsaasv2.GetSnapshotOptions) (result *sdsaasv2.Snapshot, response *core.DetailedResponse, err error) {
+	stub := record(fake, "GetSnapshot", ctx, getSnapshotOptions, &fake.GetSnapshotStub)
+	if stub == nil {
+		return nil, nil, notStubbed("GetSnapshot")
+	}
+	return stub(ctx, getSnapshotOptions)
+}
+
+// GetSnapshotReturns programs GetSnapshot to return the values.
+func (fake *Fake) GetSnapshotReturns(result *sdsaasv2.Snapshot, response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.GetSnapshotStub, func(context.Context, *sdsaasv2.GetSnapshotOptions) (*sdsaasv2.Snapshot, *core.DetailedResponse, error) {
+		return result, response, err
+	})
+}
+
+// UpdateSnapshot records the call and answers it with UpdateSnapshotStub.
+func (fake *Fake) UpdateSnapshot(updateSnapshotOptions *sdsaasv2.UpdateSnapshotOptions) (result *sdsaasv2.Snapshot, response *core.DetailedResponse, err error) {
+	return fake.UpdateSnapshotWithContext(context.Background(), updateSnapshotOptions)
+}
+
+// UpdateSnapshotWithContext records the call and answers it with UpdateSnapshotStub.
+func (fake *Fake) UpdateSnapshotWithContext(ctx context.Context, updateSnapshotOptions *sdsaasv2.UpdateSnapshotOptions) (result *sdsaasv2.Snapshot, response *core.DetailedResponse, err error) {
+	stub := record(fake, "UpdateSnapshot", ctx, updateSnapshotOptions, &fake.UpdateSnapshotStub)
+	if stub == nil {
+		return nil, nil, notStubbed("UpdateSnapshot")
+	}
+	return stub(ctx, updateSnapshotOptions)
+}
+
+// UpdateSnapshotReturns programs UpdateSnapshot to return the values.
+func (fake *Fake) UpdateSnapshotReturns(result *sdsaasv2.Snapshot, response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.UpdateSnapshotStub, func(context.Context, *sdsaasv2.UpdateSnapshotOptions) (*sdsaasv2.Snapshot, *core.DetailedResponse, error) {
+		return result, response, err
+	})
+}
+
+// ListHmacCredentials records the call and answers it with ListHmacCredentialsStub.
+func (fake *Fake) ListHmacCredentials(listHmacCredentialsOptions *sdsaasv2.ListHmacCredentialsOptions) (result *sdsaasv2.StorageCredResponse, response *core.DetailedResponse, err error) {
+	return fake.ListHmacCredentialsWithContext(context.Background(), listHmacCredentialsOptions)
+}
+
+// ListHmacCredentialsWithContext records the call and answers it with ListHmacCredentialsStub.
+func (fake *Fake) ListHmacCredentialsWithContext(ctx context.Context, listHmacCredentialsOptions *sdsaasv2.ListHmacCredentialsOptions) (result *sdsaasv2.StorageCredResponse, response *core.DetailedResponse, err error) {
+	stub := record(fake, "ListHmacCredentials", ctx, listHmacCredentialsOptions, &fake.ListHmacCredentialsStub)
+	if stub == nil {
+		return nil, nil, notStubbed("ListHmacCredentials")
+	}
+	return stub(ctx, listHmacCredentialsOptions)
+}
+
+// ListHmacCredentialsReturns programs ListHmacCredentials to return the values.
+func (fake *Fake) ListHmacCredentialsReturns(result *sdsaasv2.StorageCredResponse, response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.ListHmacCredentialsStub, func(context.Context, *sdsaasv2.ListHmacCredentialsOptions) (*sdsaasv2.StorageCredResponse, *core.DetailedResponse, error) {
+		return result, response, err
+	})
+}
+
+// DeleteHmacCredentials records the call and answers it with DeleteHmacCredentialsStub.
+func (fake *Fake) DeleteHmacCredentials(deleteHmacCredentialsOptions *sdsaasv2.DeleteHmacCredentialsOptions) (response *core.DetailedResponse, err error) {
+	return fake.DeleteHmacCredentialsWithContext(context.Background(), deleteHmacCredentialsOptions)
+}
+
+// DeleteHmacCredentialsWithContext records the call and answers it with DeleteHmacCredentialsStub.
+func (fake *Fake) DeleteHmacCredentialsWithContext(ctx context.Context, deleteHmacCredentialsOptions *sdsaasv2.DeleteHmacCredentialsOptions) (response *core.DetailedResponse, err error) {
+	stub := record(fake, "DeleteHmacCredentials", ctx, deleteHmacCredentialsOptions, &fake.DeleteHmacCredentialsStub)
+	if stub == nil {
+		return nil, notStubbed("DeleteHmacCredentials")
+	}
+	return stub(ctx, deleteHmacCredentialsOptions)
+}
+
+// DeleteHmacCredentialsReturns programs DeleteHmacCredentials to return the values.
+func (fake *Fake) DeleteHmacCredentialsReturns(response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.DeleteHmacCredentialsStub, func(context.Context, *sdsaasv2.DeleteHmacCredentialsOptions) (*core.DetailedResponse, error) {
+		return response, err
+	})
+}
+
+// CreateHmacCredentials records the call and answers it with CreateHmacCredentialsStub.
+func (fake *Fake) CreateHmacCredentials(createHmacCredentialsOptions *sdsaasv2.CreateHmacCredentialsOptions) (result *sdsaasv2.AccessKeyResponse, response *core.DetailedResponse, err error) {
+	return fake.CreateHmacCredentialsWithContext(context.Background(), createHmacCredentialsOptions)
+}
+
+// CreateHmacCredentialsWithContext records the call and answers it with CreateHmacCredentialsStub.
+func (fake *Fake) CreateHmacCredentialsWithContext(ctx context.Context, createHmacCredentialsOptions *sdsaasv2.CreateHmacCredentialsOptions) (result *sdsaasv2.AccessKeyResponse, response *core.DetailedResponse, err error) {
+	stub := record(fake, "CreateHmacCredentials", ctx, createHmacCredentialsOptions, &fake.CreateHmacCredentialsStub)
+	if stub == nil {
+		return nil, nil, notStubbed("CreateHmacCredentials")
+	}
+	return stub(ctx, createHmacCredentialsOptions)
+}
+
+// CreateHmacCredentialsReturns programs CreateHmacCredentials to return the values.
+func (fake *Fake) CreateHmacCredentialsReturns(result *sdsaasv2.AccessKeyResponse, response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.CreateHmacCredentialsStub, func(context.Context, *sdsaasv2.CreateHmacCredentialsOptions) (*sdsaasv2.AccessKeyResponse, *core.DetailedResponse, error) {
+		return result, response, err
+	})
+}
+
+// ListCertificates records the call and answers it with ListCertificatesStub.
+func (fake *Fake) ListCertificates(listCertificatesOptions *sdsaasv2.ListCertificatesOptions) (result *sdsaasv2.CertListResponse, response *core.DetailedResponse, err error) {
+	return fake.ListCertificatesWithContext(context.Background(), listCertificatesOptions)
+}
+
+// ListCertificatesWithContext records the call and answers it with ListCertificatesStub.
+func (fake *Fake) ListCertificatesWithContext(ctx context.Context, listCertificatesOptions *sdsaasv2.ListCertificatesOptions) (result *sdsaasv2.CertListResponse, response *core.DetailedResponse, err error) {
+	stub := record(fake, "ListCertificates", ctx, listCertificatesOptions, &fake.ListCertificatesStub)
+	if stub == nil {
+		return nil, nil, notStubbed("ListCertificates")
+	}
+	return stub(ctx, listCertificatesOptions)
+}
+
+// ListCertificatesReturns programs ListCertificates to return the values.
+func (fake *Fake) ListCertificatesReturns(result *sdsaasv2.CertListResponse, response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.ListCertificatesStub, func(context.Context, *sdsaasv2.ListCertificatesOptions) (*sdsaasv2.CertListResponse, *core.DetailedResponse, error) {
+		return result, response, err
+	})
+}
+
+// DeleteSslCert records the call and answers it with DeleteSslCertStub.
+func (fake *Fake) DeleteSslCert(deleteSslCertOptions *sdsaasv2.DeleteSslCertOptions) (response *core.DetailedResponse, err error) {
+	return fake.DeleteSslCertWithContext(context.Background(), deleteSslCertOptions)
+}
+
+// DeleteSslCertWithContext records the call and answers it with DeleteSslCertStub.
+func (fake *Fake) DeleteSslCertWithContext(ctx context.Context, deleteSslCertOptions *sdsaasv2.DeleteSslCertOptions) (response *core.DetailedResponse, err error) {
+	stub := record(fake, "DeleteSslCert", ctx, deleteSslCertOptions, &fake.DeleteSslCertStub)
+	if stub == nil {
+		return nil, notStubbed("DeleteSslCert")
+	}
+	return stub(ctx, deleteSslCertOptions)
+}
+
+// DeleteSslCertReturns programs DeleteSslCert to return the values.
+func (fake *Fake) DeleteSslCertReturns(response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.DeleteSslCertStub, func(context.Context, *sdsaasv2.DeleteSslCertOptions) (*core.DetailedResponse, error) {
+		return response, err
+	})
+}
+
+// GetS3SslCertStatus records the call and answers it with GetS3SslCertStatusStub.
+func (fake *Fake) GetS3SslCertStatus(getS3SslCertStatusOptions *sdsaasv2.GetS3SslCertStatusOptions) (result *sdsaasv2.StatusResponse, response *core.DetailedResponse, err error) {
+	return fake.GetS3SslCertStatusWithContext(context.Background(), getS3SslCertStatusOptions)
+}
+
+// GetS3SslCertStatusWithContext records the call and answers it with GetS3SslCertStatusStub.
+func (fake *Fake) GetS3SslCertStatusWithContext(ctx context.Context, getS3SslCertStatusOptions *sdsaasv2.GetS3SslCertStatusOptions) (result *sdsaasv2.StatusResponse, response *core.DetailedResponse, err error) {
+	stub := record(fake, "GetS3SslCertStatus", ctx, getS3SslCertStatusOptions, &fake.GetS3SslCertStatusStub)
+	if stub == nil {
+		return nil, nil, notStubbed("GetS3SslCertStatus")
+	}
+	return stub(ctx, getS3SslCertStatusOptions)
+}
+
+// GetS3SslCertStatusReturns programs GetS3SslCertStatus to return the values.
+func (fake *Fake) GetS3SslCertStatusReturns(result *sdsaasv2.StatusResponse, response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.GetS3SslCertStatusStub, func(context.Context, *sdsaasv2.GetS3SslCertStatusOptions) (*sdsaasv2.StatusResponse, *core.DetailedResponse, error) {
+		return result, response, err
+	})
+}
+
+// CreateSslCert records the call and answers it with CreateSslCertStub.
+func (fake *Fake) CreateSslCert(createSslCertOptions *sdsaasv2.CreateSslCertOptions) (result *sdsaasv2.CertResponse, response *core.DetailedResponse, err error) {
+	return fake.CreateSslCertWithContext(context.Background(), createSslCertOptions)
+}
+
+// CreateSslCertWithContext records the call and answers it with CreateSslCertStub.
+func (fake *Fake) CreateSslCertWithContext(ctx context.Context, createSslCertOptions *sdsaasv2.CreateSslCertOptions) (result *sdsaasv2.CertResponse, response *core.DetailedResponse, err error) {
+	stub := record(fake, "CreateSslCert", ctx, createSslCertOptions, &fake.CreateSslCertStub)
+	if stub == nil {
+		return nil, nil, notStubbed("CreateSslCert")
+	}
+	return stub(ctx, createSslCertOptions)
+}
+
+// CreateSslCertReturns programs CreateSslCert to return the values.
+func (fake *Fake) CreateSslCertReturns(result *sdsaasv2.CertResponse, response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.CreateSslCertStub, func(context.Context, *sdsaasv2.CreateSslCertOptions) (*sdsaasv2.CertResponse, *core.DetailedResponse, error) {
+		return result, response, err
+	})
+}
+
+// ReplaceSslCert records the call and answers it with ReplaceSslCertStub.
+func (fake *Fake) ReplaceSslCert(replaceSslCertOptions *sdsaasv2.ReplaceSslCertOptions) (result *sdsaasv2.CertResponse, response *core.DetailedResponse, err error) {
+	return fake.ReplaceSslCertWithContext(context.Background(), replaceSslCertOptions)
+}
+
+// ReplaceSslCertWithContext records the call and answers it with ReplaceSslCertStub.
+func (fake *Fake) ReplaceSslCertWithContext(ctx context.Context, replaceSslCertOptions *sdsaasv2.ReplaceSslCertOptions) (result *sdsaasv2.CertResponse, response *core.DetailedResponse, err error) {
+	stub := record(fake, "ReplaceSslCert", ctx, replaceSslCertOptions, &fake.ReplaceSslCertStub)
+	if stub == nil {
+		return nil, nil, notStubbed("ReplaceSslCert")
+	}
+	return stub(ctx, replaceSslCertOptions)
+}
+
+// ReplaceSslCertReturns programs ReplaceSslCert to return the values.
+func (fake *Fake) ReplaceSslCertReturns(result *sdsaasv2.CertResponse, response *core.DetailedResponse, err error) {
+	setStub(fake, &fake.ReplaceSslCertStub, func(context.Context, *sdsaasv2.ReplaceSslCertOptions) (*sdsaasv2.CertResponse, *core.DetailedResponse, error) {
+		return result, response, err
+	})
+}
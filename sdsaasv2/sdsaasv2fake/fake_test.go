@@ -0,0 +1,91 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2fake
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type contextKey string
+
+// volumeNames stands for code under test that only needs the volume operations.
+func volumeNames(ctx context.Context, volumes sdsaasv2.VolumesAPI) ([]string, error) {
+	collection, _, err := volumes.ListVolumesWithContext(ctx, &sdsaasv2.ListVolumesOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for _, volume := range collection.Volumes {
+		names = append(names, *volume.Name)
+	}
+	return names, nil
+}
+
+func TestFakeReturns(t *testing.T) {
+	fake := NewFake()
+	fake.ListVolumesReturns(&sdsaasv2.VolumeCollection{
+		Volumes: []sdsaasv2.Volume{{Name: core.StringPtr("data")}, {Name: core.StringPtr("logs")}},
+	}, &core.DetailedResponse{StatusCode: 200}, nil)
+
+	ctx := context.WithValue(context.Background(), contextKey("caller"), "test")
+	names, err := volumeNames(ctx, fake)
+	require.Nil(t, err)
+	assert.Equal(t, []string{"data", "logs"}, names)
+
+	calls := fake.CallsOf("ListVolumes")
+	require.Len(t, calls, 1)
+	assert.Equal(t, ctx, calls[0].Context)
+	assert.IsType(t, &sdsaasv2.ListVolumesOptions{}, calls[0].Options)
+}
+
+func TestFakeStubs(t *testing.T) {
+	fake := NewFake()
+	fake.DeleteVolumeStub = func(ctx context.Context, options *sdsaasv2.DeleteVolumeOptions) (*core.DetailedResponse, error) {
+		if *options.ID == "missing" {
+			return &core.DetailedResponse{StatusCode: 404}, errors.New("not found")
+		}
+		return &core.DetailedResponse{StatusCode: 202}, nil
+	}
+
+	response, err := fake.DeleteVolume(&sdsaasv2.DeleteVolumeOptions{ID: core.StringPtr("vol-1")})
+	require.Nil(t, err)
+	assert.Equal(t, 202, response.StatusCode)
+	response, err = fake.DeleteVolume(&sdsaasv2.DeleteVolumeOptions{ID: core.StringPtr("missing")})
+	assert.NotNil(t, err)
+	assert.Equal(t, 404, response.StatusCode)
+
+	assert.Equal(t, 2, fake.CallCount("DeleteVolume"))
+	assert.Equal(t, context.Background(), fake.Calls()[0].Context)
+	fake.Reset()
+	assert.Empty(t, fake.Calls())
+}
+
+func TestFakeNotStubbed(t *testing.T) {
+	var service sdsaasv2.SdsaasV2API = NewFake()
+	_, _, err := service.CreateHmacCredentials(&sdsaasv2.CreateHmacCredentialsOptions{AccessKey: core.StringPtr("key")})
+	assert.True(t, errors.Is(err, ErrNotStubbed))
+	assert.Contains(t, err.Error(), "CreateHmacCredentials")
+	_, err = service.DeleteSnapshots(&sdsaasv2.DeleteSnapshotsOptions{})
+	assert.True(t, errors.Is(err, ErrNotStubbed))
+}
@@ -0,0 +1,191 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2report
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+)
+
+// The title of the rendered reports.
+const reportTitle = "Usage report"
+
+// reportTable : A table of a rendered report, shared by the Markdown and HTML renderings.
+type reportTable struct {
+	Title   string
+	Summary string
+	Headers []string
+	Rows    [][]string
+
+	// When true, the first column is the name of a group, and the others are numbers.
+	Named bool
+}
+
+var usageHeaders = []string{"Volumes", "Capacity (GB)", "IOPS", "Bandwidth (Mbps)", "Snapshots", "Mappings"}
+
+var htmlReport = template.Must(template.New("report").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p>Generated at {{.GeneratedAt}}</p>
+{{- range .Tables}}
+<h2>{{.Title}}</h2>
+{{- if .Summary}}
+<p>{{.Summary}}</p>
+{{- end}}
+<table>
+<thead>
+<tr>{{range .Headers}}<th>{{.}}</th>{{end}}</tr>
+</thead>
+<tbody>
+{{- range .Rows}}
+<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>
+{{- end}}
+</tbody>
+</table>
+{{- end}}
+</body>
+</html>
+`))
+
+// RenderMarkdown writes the report as a Markdown document, with a table per section.
+func (report *Report) RenderMarkdown(writer io.Writer) (err error) {
+	var builder strings.Builder
+	fmt.Fprintf(&builder, "# %s\n\nGenerated at %s\n", reportTitle, report.GeneratedAt.UTC().Format(time.RFC3339))
+	for _, table := range report.tables() {
+		fmt.Fprintf(&builder, "\n## %s\n\n", table.Title)
+		if table.Summary != "" {
+			fmt.Fprintf(&builder, "%s\n\n", table.Summary)
+		}
+		builder.WriteString(markdownRow(table.Headers))
+		separators := make([]string, len(table.Headers))
+		for i := range separators {
+			separators[i] = "---:"
+		}
+		if table.Named {
+			separators[0] = "---"
+		}
+		builder.WriteString(markdownRow(separators))
+		for _, row := range table.Rows {
+			builder.WriteString(markdownRow(row))
+		}
+	}
+	if _, err = io.WriteString(writer, builder.String()); err != nil {
+		err = core.SDKErrorf(err, "", "report-write", common.GetComponentInfo())
+	}
+	return
+}
+
+// RenderHTML writes the report as an HTML document, with a table per section.
+func (report *Report) RenderHTML(writer io.Writer) (err error) {
+	err = htmlReport.Execute(writer, map[string]interface{}{
+		"Title":       reportTitle,
+		"GeneratedAt": report.GeneratedAt.UTC().Format(time.RFC3339),
+		"Tables":      report.tables(),
+	})
+	if err != nil {
+		err = core.SDKErrorf(err, "", "report-write", common.GetComponentInfo())
+	}
+	return
+}
+
+// RenderJSON writes the report as an indented JSON document.
+func (report *Report) RenderJSON(writer io.Writer) (err error) {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(report); err != nil {
+		err = core.SDKErrorf(err, "", "report-write", common.GetComponentInfo())
+	}
+	return
+}
+
+func (report *Report) tables() []reportTable {
+	snapshots := report.Snapshots
+	fanOut := report.Mappings
+	return []reportTable{
+		{
+			Title:   "Totals",
+			Headers: usageHeaders,
+			Rows:    [][]string{usageRow(report.Totals)},
+		},
+		groupTable("By host", "Host", report.ByHost),
+		groupTable("By name prefix", "Name prefix", report.ByNamePrefix),
+		{
+			Title: "Snapshots",
+			Summary: fmt.Sprintf("%d snapshots use %d GB, %s of the provisioned capacity; %d are deletable.",
+				snapshots.Snapshots, snapshots.Size, strconv.FormatFloat(snapshots.CapacityRatio*100, 'f', 1, 64)+"%",
+				snapshots.Deletable),
+			Headers: []string{"Source volume", "Snapshots", "Size (GB)"},
+			Rows:    snapshotRows(snapshots.BySourceVolume),
+			Named:   true,
+		},
+		{
+			Title:   "Mappings",
+			Headers: []string{"Mappings", "Mapped volumes", "Unmapped volumes", "Shared volumes", "Max hosts per volume", "Max volumes per host"},
+			Rows: [][]string{{
+				strconv.Itoa(fanOut.Mappings), strconv.Itoa(fanOut.MappedVolumes), strconv.Itoa(fanOut.UnmappedVolumes),
+				strconv.Itoa(fanOut.SharedVolumes), strconv.Itoa(fanOut.MaxHostsPerVolume), strconv.Itoa(fanOut.MaxVolumesPerHost),
+			}},
+		},
+	}
+}
+
+func groupTable(title string, nameHeader string, groups []Group) reportTable {
+	table := reportTable{Title: title, Headers: append([]string{nameHeader}, usageHeaders...), Named: true}
+	for _, group := range groups {
+		table.Rows = append(table.Rows, append([]string{group.Name}, usageRow(group.Usage)...))
+	}
+	return table
+}
+
+func usageRow(usage Usage) []string {
+	return []string{
+		strconv.Itoa(usage.Volumes),
+		strconv.FormatInt(usage.Capacity, 10),
+		strconv.FormatInt(usage.Iops, 10),
+		strconv.FormatInt(usage.Bandwidth, 10),
+		strconv.FormatInt(usage.SnapshotCount, 10),
+		strconv.Itoa(usage.Mappings),
+	}
+}
+
+func snapshotRows(groups []SnapshotGroup) (rows [][]string) {
+	for _, group := range groups {
+		rows = append(rows, []string{group.Name, strconv.Itoa(group.Snapshots), strconv.FormatInt(group.Size, 10)})
+	}
+	return
+}
+
+func markdownRow(cells []string) string {
+	escaped := make([]string, len(cells))
+	for i, cell := range cells {
+		escaped[i] = strings.ReplaceAll(cell, "|", `\|`)
+	}
+	return "| " + strings.Join(escaped, " | ") + " |\n"
+}
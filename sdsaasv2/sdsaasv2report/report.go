@@ -0,0 +1,389 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package sdsaasv2report aggregates the provisioned capacity, IOPS and bandwidth of the volumes of an instance of
+// the SdsaasV2 service, with breakdowns by host and by name prefix, the usage of the snapshots and the fan-out of
+// the volume mappings, and renders the reports in Markdown, HTML and JSON.
+package sdsaasv2report
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+)
+
+const (
+	// The group of the volumes that are not mapped to any host.
+	UnmappedGroup = "(unmapped)"
+
+	// The group of the volumes and snapshots without a name, or of the snapshots without a source volume.
+	UnnamedGroup = "(unnamed)"
+)
+
+// Client : The operations of the SdsaasV2 service that Collect uses, implemented by *sdsaasv2.SdsaasV2 and
+// sdsaasv2fake.Fake.
+type Client interface {
+	sdsaasv2.VolumesAPI
+	sdsaasv2.HostsAPI
+	sdsaasv2.SnapshotsAPI
+}
+
+// Options : The options of a Report.
+type Options struct {
+	// Returns the name prefix that a volume is grouped by. DefaultNamePrefix when nil.
+	NamePrefix func(name string) string
+
+	// Returns the current time, for the GeneratedAt of the report. time.Now when nil.
+	Now func() time.Time
+}
+
+// Usage : The provisioned resources of a set of volumes. Capacities are in gigabytes and bandwidths in megabits
+// per second.
+type Usage struct {
+	Volumes       int   `json:"volumes"`
+	Capacity      int64 `json:"capacity"`
+	Iops          int64 `json:"iops"`
+	Bandwidth     int64 `json:"bandwidth"`
+	SnapshotCount int64 `json:"snapshot_count"`
+	Mappings      int   `json:"mappings"`
+}
+
+// Group : The usage of the volumes of a host or of a name prefix.
+type Group struct {
+	// The ID of the host; empty for a name prefix, and for the unmapped volumes.
+	ID string `json:"id,omitempty"`
+
+	Name string `json:"name"`
+	Usage
+}
+
+// SnapshotGroup : The snapshots of a source volume.
+type SnapshotGroup struct {
+	// The ID of the source volume; empty for the snapshots without a source volume.
+	ID string `json:"id,omitempty"`
+
+	Name      string `json:"name"`
+	Snapshots int    `json:"snapshots"`
+	Size      int64  `json:"size"`
+}
+
+// SnapshotUsage : The storage tied up in snapshots. Sizes are in gigabytes.
+type SnapshotUsage struct {
+	Snapshots       int   `json:"snapshots"`
+	Size            int64 `json:"size"`
+	MinimumCapacity int64 `json:"minimum_capacity"`
+	Deletable       int   `json:"deletable"`
+
+	// The size of the snapshots as a fraction of the provisioned capacity of the volumes.
+	CapacityRatio float64 `json:"capacity_ratio"`
+
+	BySourceVolume []SnapshotGroup `json:"by_source_volume"`
+}
+
+// MappingFanOut : How the volumes are mapped to the hosts.
+type MappingFanOut struct {
+	Mappings        int `json:"mappings"`
+	MappedVolumes   int `json:"mapped_volumes"`
+	UnmappedVolumes int `json:"unmapped_volumes"`
+
+	// The volumes that are mapped to more than one host.
+	SharedVolumes int `json:"shared_volumes"`
+
+	MaxHostsPerVolume int `json:"max_hosts_per_volume"`
+	MaxVolumesPerHost int `json:"max_volumes_per_host"`
+}
+
+// Report : The usage of an instance of the SdsaasV2 service. The groups are sorted by capacity, or size, largest
+// first. A volume mapped to several hosts counts towards each of them.
+type Report struct {
+	GeneratedAt  time.Time     `json:"generated_at"`
+	Totals       Usage         `json:"totals"`
+	ByHost       []Group       `json:"by_host"`
+	ByNamePrefix []Group       `json:"by_name_prefix"`
+	Snapshots    SnapshotUsage `json:"snapshots"`
+	Mappings     MappingFanOut `json:"mappings"`
+}
+
+// DefaultNamePrefix returns the part of a name before its first '-', '_' or '.', e.g. "db" for "db-data-1", or the
+// whole name when it has none of them.
+func DefaultNamePrefix(name string) string {
+	if i := strings.IndexAny(name, "-_."); i > 0 {
+		return name[:i]
+	}
+	return name
+}
+
+// Collect lists the volumes, hosts and snapshots of the instance, reading every page, and returns their report.
+func Collect(ctx context.Context, client Client, options *Options) (report *Report, err error) {
+	volumes, err := listAll(ctx, func(start *string) ([]sdsaasv2.Volume, *string, error) {
+		page, _, err := client.ListVolumesWithContext(ctx, &sdsaasv2.ListVolumesOptions{Start: start})
+		if err != nil {
+			return nil, nil, err
+		}
+		next, err := page.GetNextStart()
+		return page.Volumes, next, err
+	})
+	if err != nil {
+		err = core.SDKErrorf(err, "", "report-list-volumes", common.GetComponentInfo())
+		return
+	}
+	hosts, err := listAll(ctx, func(start *string) ([]sdsaasv2.Host, *string, error) {
+		page, _, err := client.ListHostsWithContext(ctx, &sdsaasv2.ListHostsOptions{Start: start})
+		if err != nil {
+			return nil, nil, err
+		}
+		next, err := page.GetNextStart()
+		return page.Hosts, next, err
+	})
+	if err != nil {
+		err = core.SDKErrorf(err, "", "report-list-hosts", common.GetComponentInfo())
+		return
+	}
+	snapshots, err := listAll(ctx, func(start *string) ([]sdsaasv2.Snapshot, *string, error) {
+		page, _, err := client.ListSnapshotsWithContext(ctx, &sdsaasv2.ListSnapshotsOptions{Start: start})
+		if err != nil {
+			return nil, nil, err
+		}
+		next, err := page.GetNextStart()
+		return page.Snapshots, next, err
+	})
+	if err != nil {
+		err = core.SDKErrorf(err, "", "report-list-snapshots", common.GetComponentInfo())
+		return
+	}
+	report = NewReport(volumes, hosts, snapshots, options)
+	return
+}
+
+// listAll reads the pages of a list operation until the last one.
+func listAll[T any](ctx context.Context, list func(start *string) (items []T, next *string, err error)) (all []T, err error) {
+	var start *string
+	for {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		var items []T
+		items, start, err = list(start)
+		if err != nil {
+			return
+		}
+		all = append(all, items...)
+		if start == nil {
+			return
+		}
+	}
+}
+
+// NewReport : aggregates the usage of volumes, hosts and snapshots, e.g. those of a whole instance. The hosts
+// only name the groups of the hosts, and add groups for the hosts without volumes; the mappings are read from the
+// volumes.
+func NewReport(volumes []sdsaasv2.Volume, hosts []sdsaasv2.Host, snapshots []sdsaasv2.Snapshot, options *Options) *Report {
+	if options == nil {
+		options = &Options{}
+	}
+	namePrefix := options.NamePrefix
+	if namePrefix == nil {
+		namePrefix = DefaultNamePrefix
+	}
+	now := options.Now
+	if now == nil {
+		now = time.Now
+	}
+
+	report := &Report{GeneratedAt: now()}
+	// The groups of the hosts are keyed by host ID, since host names need not be unique.
+	byHost := map[string]*Usage{}
+	hostGroups := map[string]Group{UnmappedGroup: {Name: UnmappedGroup}}
+	hostKey := func(id *string, name *string) string {
+		key := resourceKey(id, name)
+		if _, ok := hostGroups[key]; !ok {
+			hostGroups[key] = Group{ID: core.StringNilMapper(id), Name: resourceName(id, name)}
+		}
+		return key
+	}
+	for _, host := range hosts {
+		group(byHost, hostKey(host.ID, host.Name))
+	}
+	byNamePrefix := map[string]*Usage{}
+
+	for _, volume := range volumes {
+		usage := volumeUsage(volume)
+		report.Totals.add(usage)
+
+		prefix := UnnamedGroup
+		if volume.Name != nil && *volume.Name != "" {
+			prefix = namePrefix(*volume.Name)
+		}
+		group(byNamePrefix, prefix).add(usage)
+
+		// The mappings of the volume, by host.
+		mappings := map[string]int{}
+		for _, mapping := range volume.VolumeMappings {
+			key := UnnamedGroup
+			if mapping.Host != nil {
+				key = hostKey(mapping.Host.ID, mapping.Host.Name)
+			}
+			mappings[key]++
+		}
+		if len(mappings) == 0 {
+			report.Mappings.UnmappedVolumes++
+			group(byHost, UnmappedGroup).add(usage)
+			continue
+		}
+		report.Mappings.MappedVolumes++
+		if len(mappings) > 1 {
+			report.Mappings.SharedVolumes++
+		}
+		report.Mappings.MaxHostsPerVolume = max(report.Mappings.MaxHostsPerVolume, len(mappings))
+		for key, count := range mappings {
+			hostUsage := usage
+			hostUsage.Mappings = count
+			group(byHost, key).add(hostUsage)
+		}
+	}
+	report.Mappings.Mappings = report.Totals.Mappings
+	for key, usage := range byHost {
+		if key != UnmappedGroup {
+			report.Mappings.MaxVolumesPerHost = max(report.Mappings.MaxVolumesPerHost, usage.Volumes)
+		}
+	}
+	report.ByHost = sortedGroups(byHost, hostGroups)
+	report.ByNamePrefix = sortedGroups(byNamePrefix, nil)
+
+	bySourceVolume := map[string]*SnapshotGroup{}
+	for _, snapshot := range snapshots {
+		size := int64Value(snapshot.Size)
+		report.Snapshots.Snapshots++
+		report.Snapshots.Size += size
+		report.Snapshots.MinimumCapacity += int64Value(snapshot.MinimumCapacity)
+		if snapshot.Deletable != nil && *snapshot.Deletable {
+			report.Snapshots.Deletable++
+		}
+		// The groups are keyed by volume ID, since volume names need not be unique.
+		key := UnnamedGroup
+		if snapshot.SourceVolume != nil {
+			key = resourceKey(snapshot.SourceVolume.ID, snapshot.SourceVolume.Name)
+		}
+		if bySourceVolume[key] == nil {
+			bySourceVolume[key] = &SnapshotGroup{Name: UnnamedGroup}
+			if snapshot.SourceVolume != nil {
+				bySourceVolume[key].ID = core.StringNilMapper(snapshot.SourceVolume.ID)
+				bySourceVolume[key].Name = resourceName(snapshot.SourceVolume.ID, snapshot.SourceVolume.Name)
+			}
+		}
+		bySourceVolume[key].Snapshots++
+		bySourceVolume[key].Size += size
+	}
+	if report.Totals.Capacity > 0 {
+		report.Snapshots.CapacityRatio = float64(report.Snapshots.Size) / float64(report.Totals.Capacity)
+	}
+	report.Snapshots.BySourceVolume = []SnapshotGroup{}
+	for _, snapshotGroup := range bySourceVolume {
+		report.Snapshots.BySourceVolume = append(report.Snapshots.BySourceVolume, *snapshotGroup)
+	}
+	sort.Slice(report.Snapshots.BySourceVolume, func(i, j int) bool {
+		a, b := report.Snapshots.BySourceVolume[i], report.Snapshots.BySourceVolume[j]
+		if a.Size != b.Size {
+			return a.Size > b.Size
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.ID < b.ID
+	})
+	return report
+}
+
+func volumeUsage(volume sdsaasv2.Volume) Usage {
+	return Usage{
+		Volumes:       1,
+		Capacity:      int64Value(volume.Capacity),
+		Iops:          int64Value(volume.Iops),
+		Bandwidth:     int64Value(volume.Bandwidth),
+		SnapshotCount: int64Value(volume.SnapshotCount),
+		Mappings:      len(volume.VolumeMappings),
+	}
+}
+
+func (usage *Usage) add(other Usage) {
+	usage.Volumes += other.Volumes
+	usage.Capacity += other.Capacity
+	usage.Iops += other.Iops
+	usage.Bandwidth += other.Bandwidth
+	usage.SnapshotCount += other.SnapshotCount
+	usage.Mappings += other.Mappings
+}
+
+func group(groups map[string]*Usage, name string) *Usage {
+	if groups[name] == nil {
+		groups[name] = &Usage{}
+	}
+	return groups[name]
+}
+
+// sortedGroups returns the groups, keyed by their name or by the key of their identity, largest first.
+func sortedGroups(groups map[string]*Usage, identities map[string]Group) []Group {
+	sorted := []Group{}
+	for key, usage := range groups {
+		identity, ok := identities[key]
+		if !ok {
+			identity = Group{Name: key}
+		}
+		identity.Usage = *usage
+		sorted = append(sorted, identity)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Capacity != sorted[j].Capacity {
+			return sorted[i].Capacity > sorted[j].Capacity
+		}
+		if sorted[i].Name != sorted[j].Name {
+			return sorted[i].Name < sorted[j].Name
+		}
+		return sorted[i].ID < sorted[j].ID
+	})
+	return sorted
+}
+
+// resourceKey returns the ID of a resource, or its name when it has no ID.
+func resourceKey(id *string, name *string) string {
+	if id != nil && *id != "" {
+		return *id
+	}
+	return resourceName(nil, name)
+}
+
+// resourceName returns the name of a resource, or its ID when it has no name.
+func resourceName(id *string, name *string) string {
+	if name != nil && *name != "" {
+		return *name
+	}
+	if id != nil && *id != "" {
+		return *id
+	}
+	return UnnamedGroup
+}
+
+func int64Value(value *int64) int64 {
+	if value == nil {
+		return 0
+	}
+	return *value
+}
@@ -0,0 +1,176 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2report
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2/sdsaasv2fake"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var generatedAt = time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+func testVolume(id string, name string, capacity int64, snapshots int64, hosts ...string) sdsaasv2.Volume {
+	volume := sdsaasv2.Volume{
+		ID:            core.StringPtr(id),
+		Name:          core.StringPtr(name),
+		Capacity:      core.Int64Ptr(capacity),
+		Iops:          core.Int64Ptr(1000),
+		Bandwidth:     core.Int64Ptr(100),
+		SnapshotCount: core.Int64Ptr(snapshots),
+	}
+	for _, host := range hosts {
+		volume.VolumeMappings = append(volume.VolumeMappings, sdsaasv2.VolumeMapping{Host: &sdsaasv2.HostReference{ID: core.StringPtr(host)}})
+	}
+	return volume
+}
+
+func testSnapshot(volume string, size int64, deletable bool) sdsaasv2.Snapshot {
+	return sdsaasv2.Snapshot{
+		Size:            core.Int64Ptr(size),
+		MinimumCapacity: core.Int64Ptr(size * 2),
+		Deletable:       core.BoolPtr(deletable),
+		SourceVolume:    &sdsaasv2.SourceVolume{ID: core.StringPtr(volume), Name: core.StringPtr("vol-" + volume)},
+	}
+}
+
+func testReport() *Report {
+	volumes := []sdsaasv2.Volume{
+		testVolume("v1", "db-data", 100, 2, "h1", "h2"),
+		testVolume("v2", "db-logs", 20, 0, "h1"),
+		testVolume("v3", "web.cache", 50, 1),
+	}
+	hosts := []sdsaasv2.Host{
+		{ID: core.StringPtr("h1"), Name: core.StringPtr("node-1")},
+		{ID: core.StringPtr("h2"), Name: core.StringPtr("node-2")},
+		{ID: core.StringPtr("h3"), Name: core.StringPtr("node-3")},
+	}
+	snapshots := []sdsaasv2.Snapshot{testSnapshot("v1", 10, true), testSnapshot("v1", 5, false), testSnapshot("v3", 2, true)}
+	return NewReport(volumes, hosts, snapshots, &Options{Now: func() time.Time { return generatedAt }})
+}
+
+func TestNewReport(t *testing.T) {
+	report := testReport()
+
+	assert.Equal(t, Usage{Volumes: 3, Capacity: 170, Iops: 3000, Bandwidth: 300, SnapshotCount: 3, Mappings: 3}, report.Totals)
+	assert.Equal(t, []Group{
+		{ID: "h1", Name: "node-1", Usage: Usage{Volumes: 2, Capacity: 120, Iops: 2000, Bandwidth: 200, SnapshotCount: 2, Mappings: 2}},
+		{ID: "h2", Name: "node-2", Usage: Usage{Volumes: 1, Capacity: 100, Iops: 1000, Bandwidth: 100, SnapshotCount: 2, Mappings: 1}},
+		{Name: UnmappedGroup, Usage: Usage{Volumes: 1, Capacity: 50, Iops: 1000, Bandwidth: 100, SnapshotCount: 1}},
+		{ID: "h3", Name: "node-3"},
+	}, report.ByHost)
+	assert.Equal(t, []Group{
+		{Name: "db", Usage: Usage{Volumes: 2, Capacity: 120, Iops: 2000, Bandwidth: 200, SnapshotCount: 2, Mappings: 3}},
+		{Name: "web", Usage: Usage{Volumes: 1, Capacity: 50, Iops: 1000, Bandwidth: 100, SnapshotCount: 1}},
+	}, report.ByNamePrefix)
+	assert.Equal(t, SnapshotUsage{
+		Snapshots:       3,
+		Size:            17,
+		MinimumCapacity: 34,
+		Deletable:       2,
+		CapacityRatio:   0.1,
+		BySourceVolume:  []SnapshotGroup{{ID: "v1", Name: "vol-v1", Snapshots: 2, Size: 15}, {ID: "v3", Name: "vol-v3", Snapshots: 1, Size: 2}},
+	}, report.Snapshots)
+	assert.Equal(t, MappingFanOut{Mappings: 3, MappedVolumes: 2, UnmappedVolumes: 1, SharedVolumes: 1, MaxHostsPerVolume: 2, MaxVolumesPerHost: 2}, report.Mappings)
+}
+
+func TestNewReportHostsWithTheSameName(t *testing.T) {
+	volumes := []sdsaasv2.Volume{
+		testVolume("v1", "db-data", 100, 0, "h1"),
+		testVolume("v2", "db-logs", 20, 0, "h1"),
+		testVolume("v3", "web-cache", 50, 0, "h2"),
+	}
+	hosts := []sdsaasv2.Host{
+		{ID: core.StringPtr("h1"), Name: core.StringPtr("node")},
+		{ID: core.StringPtr("h2"), Name: core.StringPtr("node")},
+	}
+	report := NewReport(volumes, hosts, nil, nil)
+
+	assert.Equal(t, []Group{
+		{ID: "h1", Name: "node", Usage: Usage{Volumes: 2, Capacity: 120, Iops: 2000, Bandwidth: 200, Mappings: 2}},
+		{ID: "h2", Name: "node", Usage: Usage{Volumes: 1, Capacity: 50, Iops: 1000, Bandwidth: 100, Mappings: 1}},
+	}, report.ByHost)
+	assert.Equal(t, 2, report.Mappings.MaxVolumesPerHost)
+}
+
+func TestNewReportSourceVolumesWithTheSameName(t *testing.T) {
+	snapshots := []sdsaasv2.Snapshot{testSnapshot("v1", 10, true), testSnapshot("v2", 5, true), testSnapshot("v1", 1, true)}
+	snapshots[1].SourceVolume.Name = snapshots[0].SourceVolume.Name
+	report := NewReport(nil, nil, snapshots, nil)
+
+	assert.Equal(t, []SnapshotGroup{
+		{ID: "v1", Name: "vol-v1", Snapshots: 2, Size: 11},
+		{ID: "v2", Name: "vol-v1", Snapshots: 1, Size: 5},
+	}, report.Snapshots.BySourceVolume)
+}
+
+func TestRender(t *testing.T) {
+	report := testReport()
+
+	var markdown bytes.Buffer
+	require.Nil(t, report.RenderMarkdown(&markdown))
+	assert.Contains(t, markdown.String(), "# Usage report\n\nGenerated at 2026-10-19T12:00:00Z\n")
+	assert.Contains(t, markdown.String(), "| Host | Volumes | Capacity (GB) | IOPS | Bandwidth (Mbps) | Snapshots | Mappings |\n"+
+		"| --- | ---: | ---: | ---: | ---: | ---: | ---: |\n"+
+		"| node-1 | 2 | 120 | 2000 | 200 | 2 | 2 |\n")
+	assert.Contains(t, markdown.String(), "3 snapshots use 17 GB, 10.0% of the provisioned capacity; 2 are deletable.")
+
+	var html bytes.Buffer
+	require.Nil(t, report.RenderHTML(&html))
+	assert.Contains(t, html.String(), "<h2>By name prefix</h2>")
+	assert.Contains(t, html.String(), "<tr><td>db</td><td>2</td><td>120</td><td>2000</td><td>200</td><td>2</td><td>3</td></tr>")
+
+	var decoded Report
+	var encoded bytes.Buffer
+	require.Nil(t, report.RenderJSON(&encoded))
+	require.Nil(t, json.Unmarshal(encoded.Bytes(), &decoded))
+	assert.Equal(t, *report, decoded)
+	assert.Contains(t, encoded.String(), `"capacity": 170`)
+}
+
+func TestCollect(t *testing.T) {
+	fake := sdsaasv2fake.NewFake()
+	fake.ListVolumesStub = func(ctx context.Context, options *sdsaasv2.ListVolumesOptions) (*sdsaasv2.VolumeCollection, *core.DetailedResponse, error) {
+		if options.Start == nil {
+			next := &sdsaasv2.PageLink{Href: core.StringPtr("https://sds.example/volumes?start=p2")}
+			return &sdsaasv2.VolumeCollection{Volumes: []sdsaasv2.Volume{testVolume("v1", "db-data", 100, 0, "h1")}, Next: next}, nil, nil
+		}
+		assert.Equal(t, "p2", *options.Start)
+		return &sdsaasv2.VolumeCollection{Volumes: []sdsaasv2.Volume{testVolume("v2", "db-logs", 20, 0)}}, nil, nil
+	}
+	fake.ListHostsReturns(&sdsaasv2.HostCollection{Hosts: []sdsaasv2.Host{{ID: core.StringPtr("h1"), Name: core.StringPtr("node-1")}}}, nil, nil)
+	fake.ListSnapshotsReturns(&sdsaasv2.SnapshotCollection{}, nil, nil)
+
+	report, err := Collect(context.Background(), fake, nil)
+	require.Nil(t, err)
+	assert.Equal(t, 2, fake.CallCount("ListVolumes"))
+	assert.Equal(t, int64(120), report.Totals.Capacity)
+	assert.Equal(t, []Group{{Name: "db", Usage: Usage{Volumes: 2, Capacity: 120, Iops: 2000, Bandwidth: 200, Mappings: 1}}}, report.ByNamePrefix)
+
+	fake.ListSnapshotsReturns(nil, nil, errors.New("unavailable"))
+	_, err = Collect(context.Background(), fake, nil)
+	assert.ErrorContains(t, err, "unavailable")
+}
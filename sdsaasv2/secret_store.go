@@ -0,0 +1,354 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+)
+
+// FileSecretStoreKeySize is the size of the AES-256 key of a FileSecretStore.
+const FileSecretStoreKeySize = 32
+
+// ErrSecretNotFound is returned by a SecretStore when no secret has the given name.
+var ErrSecretNotFound = errors.New("secret not found")
+
+// fileSecretStoreMagic starts every file written by a FileSecretStore and identifies its format version.
+var fileSecretStoreMagic = []byte("SDSSECRETS1\n")
+
+// SecretStore : Stores secrets returned by the API, such as the secret key of an HMAC credential, so that they do
+// not need to be kept by the application. Implementations must be safe for concurrent use.
+type SecretStore interface {
+	// PutSecret stores the secret under the name, replacing any previous value.
+	PutSecret(ctx context.Context, name string, secret []byte) error
+
+	// GetSecret returns the secret stored under the name, or an error wrapping ErrSecretNotFound.
+	GetSecret(ctx context.Context, name string) ([]byte, error)
+
+	// DeleteSecret removes the secret stored under the name. Deleting a missing secret is not an error.
+	DeleteSecret(ctx context.Context, name string) error
+}
+
+// SecretHandle : A reference to a secret held by a SecretStore.
+type SecretHandle struct {
+	// The store holding the secret.
+	Store SecretStore `json:"-"`
+
+	// The name of the secret in the store.
+	Name string `json:"name"`
+}
+
+// Secret reads the secret from its store.
+func (handle *SecretHandle) Secret(ctx context.Context) ([]byte, error) {
+	return handle.Store.GetSecret(ctx, handle.Name)
+}
+
+// HmacCredentialsHandle : An HMAC credential whose secret key was written to a SecretStore.
+type HmacCredentialsHandle struct {
+	// The access key of the HMAC credential.
+	AccessKey *string `json:"access_key,omitempty"`
+
+	// The secret key of the HMAC credential.
+	SecretKey *SecretHandle `json:"secret_key,omitempty"`
+}
+
+// CreateHmacCredentialsInStore : Create HMAC credentials and keep the secret key in a secret store
+// Creates an HMAC credential like CreateHmacCredentials, but writes the generated secret key to the store under the
+// name of the access key and only returns a handle to it. If the secret key cannot be stored, the credential is
+// deleted again, since its secret key cannot be retrieved later.
+func (sdsaas *SdsaasV2) CreateHmacCredentialsInStore(createHmacCredentialsOptions *CreateHmacCredentialsOptions, store SecretStore) (result *HmacCredentialsHandle, response *core.DetailedResponse, err error) {
+	result, response, err = sdsaas.CreateHmacCredentialsInStoreWithContext(context.Background(), createHmacCredentialsOptions, store)
+	err = core.RepurposeSDKProblem(err, "")
+	return
+}
+
+// CreateHmacCredentialsInStoreWithContext is an alternate form of the CreateHmacCredentialsInStore method which supports a Context parameter
+func (sdsaas *SdsaasV2) CreateHmacCredentialsInStoreWithContext(ctx context.Context, createHmacCredentialsOptions *CreateHmacCredentialsOptions, store SecretStore) (result *HmacCredentialsHandle, response *core.DetailedResponse, err error) {
+	err = core.ValidateNotNil(createHmacCredentialsOptions, "createHmacCredentialsOptions cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	err = core.ValidateNotNil(store, "store cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+
+	var credentials *AccessKeyResponse
+	credentials, response, err = sdsaas.CreateHmacCredentialsWithContext(ctx, createHmacCredentialsOptions)
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "create-hmac-credentials-error")
+		return
+	}
+	// The secret key is only kept by the store, not by the DetailedResponse.
+	response.Result = nil
+	if credentials == nil || credentials.SecretKey == nil || *credentials.SecretKey == "" {
+		err = core.SDKErrorf(nil, "the response does not include a secret key", "missing-secret-key", common.GetComponentInfo())
+		return
+	}
+	accessKey := *createHmacCredentialsOptions.AccessKey
+	err = store.PutSecret(ctx, accessKey, []byte(*credentials.SecretKey))
+	if err != nil {
+		_, _ = sdsaas.DeleteHmacCredentialsWithContext(ctx, sdsaas.NewDeleteHmacCredentialsOptions(accessKey))
+		err = core.SDKErrorf(err, fmt.Sprintf("the secret key of %q could not be stored; the credential was deleted", accessKey), "secret-store-error", common.GetComponentInfo())
+		return
+	}
+
+	result = &HmacCredentialsHandle{
+		AccessKey: core.StringPtr(accessKey),
+		SecretKey: &SecretHandle{Store: store, Name: accessKey},
+	}
+	response.Result = result
+	return
+}
+
+// NewSecretStoreSink returns an HmacCredentialSink that writes the secret key to the store under the name of the
+// access key, for use with RotateHmacCredentials.
+func NewSecretStoreSink(store SecretStore) HmacCredentialSink {
+	return func(ctx context.Context, credentials *AccessKeyResponse) error {
+		if credentials.AccessKey == nil || credentials.SecretKey == nil {
+			return core.SDKErrorf(nil, "the credential has no secret key to store", "missing-secret-key", common.GetComponentInfo())
+		}
+		return store.PutSecret(ctx, *credentials.AccessKey, []byte(*credentials.SecretKey))
+	}
+}
+
+// MemorySecretStore : A SecretStore that keeps secrets in memory, for tests and short-lived processes.
+type MemorySecretStore struct {
+	mutex   sync.Mutex
+	secrets map[string][]byte
+}
+
+// NewMemorySecretStore : Instantiate MemorySecretStore
+func NewMemorySecretStore() *MemorySecretStore {
+	return &MemorySecretStore{secrets: map[string][]byte{}}
+}
+
+// PutSecret stores a copy of the secret.
+func (store *MemorySecretStore) PutSecret(ctx context.Context, name string, secret []byte) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.secrets[name] = bytes.Clone(secret)
+	return nil
+}
+
+// GetSecret returns a copy of the secret.
+func (store *MemorySecretStore) GetSecret(ctx context.Context, name string) ([]byte, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	secret, ok := store.secrets[name]
+	if !ok {
+		return nil, secretNotFound(name)
+	}
+	return bytes.Clone(secret), nil
+}
+
+// DeleteSecret removes the secret.
+func (store *MemorySecretStore) DeleteSecret(ctx context.Context, name string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	delete(store.secrets, name)
+	return nil
+}
+
+// EnvSecretStore : A SecretStore that keeps secrets in environment variables of the current process, so that they
+// are inherited by child processes. The variable of a secret is its name prefixed by Prefix, upper-cased, with every
+// character that is not a letter or a digit replaced by an underscore.
+type EnvSecretStore struct {
+	Prefix string
+}
+
+// NewEnvSecretStore : Instantiate EnvSecretStore
+func NewEnvSecretStore(prefix string) *EnvSecretStore {
+	return &EnvSecretStore{Prefix: prefix}
+}
+
+// VariableName returns the environment variable that holds the named secret.
+func (store *EnvSecretStore) VariableName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if ('A' <= r && r <= 'Z') || ('0' <= r && r <= '9') {
+			return r
+		}
+		return '_'
+	}, strings.ToUpper(store.Prefix+name))
+}
+
+// PutSecret sets the environment variable of the secret.
+func (store *EnvSecretStore) PutSecret(ctx context.Context, name string, secret []byte) error {
+	err := os.Setenv(store.VariableName(name), string(secret))
+	if err != nil {
+		return core.SDKErrorf(err, "", "secret-store-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// GetSecret reads the environment variable of the secret.
+func (store *EnvSecretStore) GetSecret(ctx context.Context, name string) ([]byte, error) {
+	secret, ok := os.LookupEnv(store.VariableName(name))
+	if !ok {
+		return nil, secretNotFound(name)
+	}
+	return []byte(secret), nil
+}
+
+// DeleteSecret unsets the environment variable of the secret.
+func (store *EnvSecretStore) DeleteSecret(ctx context.Context, name string) error {
+	err := os.Unsetenv(store.VariableName(name))
+	if err != nil {
+		return core.SDKErrorf(err, "", "secret-store-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+// FileSecretStore : A SecretStore that keeps all of its secrets in one file, encrypted with AES-256-GCM. The file is
+// replaced atomically and is only readable by its owner.
+type FileSecretStore struct {
+	path  string
+	aead  cipher.AEAD
+	mutex sync.Mutex
+}
+
+// NewFileSecretStore : Instantiate FileSecretStore with a key of FileSecretStoreKeySize random bytes. The file is
+// created when the first secret is stored.
+func NewFileSecretStore(path string, key []byte) (*FileSecretStore, error) {
+	if len(key) != FileSecretStoreKeySize {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("the key must be %d bytes long", FileSecretStoreKeySize), "invalid-key", common.GetComponentInfo())
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "invalid-key", common.GetComponentInfo())
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "invalid-key", common.GetComponentInfo())
+	}
+	return &FileSecretStore{path: path, aead: aead}, nil
+}
+
+// PutSecret encrypts the secret into the file.
+func (store *FileSecretStore) PutSecret(ctx context.Context, name string, secret []byte) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	secrets, err := store.read()
+	if err != nil {
+		return err
+	}
+	secrets[name] = secret
+	return store.write(secrets)
+}
+
+// GetSecret decrypts the secret from the file.
+func (store *FileSecretStore) GetSecret(ctx context.Context, name string) ([]byte, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	secrets, err := store.read()
+	if err != nil {
+		return nil, err
+	}
+	secret, ok := secrets[name]
+	if !ok {
+		return nil, secretNotFound(name)
+	}
+	return secret, nil
+}
+
+// DeleteSecret removes the secret from the file.
+func (store *FileSecretStore) DeleteSecret(ctx context.Context, name string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	secrets, err := store.read()
+	if err != nil {
+		return err
+	}
+	if _, ok := secrets[name]; !ok {
+		return nil
+	}
+	delete(secrets, name)
+	return store.write(secrets)
+}
+
+// read decrypts the file, which holds the magic header, the nonce and the sealed JSON map of secrets.
+func (store *FileSecretStore) read() (secrets map[string][]byte, err error) {
+	secrets = map[string][]byte{}
+	data, err := os.ReadFile(store.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return secrets, nil
+	}
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "secret-store-error", common.GetComponentInfo())
+	}
+
+	nonceSize := store.aead.NonceSize()
+	if !bytes.HasPrefix(data, fileSecretStoreMagic) || len(data) < len(fileSecretStoreMagic)+nonceSize {
+		return nil, core.SDKErrorf(nil, fmt.Sprintf("%s is not a secret store file", store.path), "secret-store-format-error", common.GetComponentInfo())
+	}
+	data = data[len(fileSecretStoreMagic):]
+	plaintext, err := store.aead.Open(nil, data[:nonceSize], data[nonceSize:], fileSecretStoreMagic)
+	if err != nil {
+		return nil, core.SDKErrorf(err, fmt.Sprintf("%s cannot be decrypted with this key", store.path), "secret-store-decrypt-error", common.GetComponentInfo())
+	}
+	defer clear(plaintext)
+	err = json.Unmarshal(plaintext, &secrets)
+	if err != nil {
+		return nil, core.SDKErrorf(err, "", "secret-store-format-error", common.GetComponentInfo())
+	}
+	return secrets, nil
+}
+
+func (store *FileSecretStore) write(secrets map[string][]byte) error {
+	plaintext, err := json.Marshal(secrets)
+	if err != nil {
+		return core.SDKErrorf(err, "", "secret-store-error", common.GetComponentInfo())
+	}
+	defer clear(plaintext)
+
+	nonce := make([]byte, store.aead.NonceSize())
+	_, err = io.ReadFull(rand.Reader, nonce)
+	if err != nil {
+		return core.SDKErrorf(err, "", "secret-store-error", common.GetComponentInfo())
+	}
+	data := append(bytes.Clone(fileSecretStoreMagic), nonce...)
+	data = store.aead.Seal(data, nonce, plaintext, fileSecretStoreMagic)
+
+	err = os.MkdirAll(filepath.Dir(store.path), 0700)
+	if err == nil {
+		err = writeFileAtomic(store.path, data, 0600)
+	}
+	if err != nil {
+		return core.SDKErrorf(err, "", "secret-store-error", common.GetComponentInfo())
+	}
+	return nil
+}
+
+func secretNotFound(name string) error {
+	return core.SDKErrorf(ErrSecretNotFound, fmt.Sprintf("secret %q not found", name), "secret-not-found", common.GetComponentInfo())
+}
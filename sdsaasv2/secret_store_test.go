@@ -0,0 +1,163 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// failingSecretStore rejects every secret.
+type failingSecretStore struct {
+	sdsaasv2.MemorySecretStore
+}
+
+func (*failingSecretStore) PutSecret(ctx context.Context, name string, secret []byte) error {
+	return errors.New("store is read-only")
+}
+
+var _ = Describe(`SecretStore`, func() {
+	ctx := context.Background()
+	key := bytes.Repeat([]byte{7}, sdsaasv2.FileSecretStoreKeySize)
+
+	testStore := func(store sdsaasv2.SecretStore) {
+		_, err := store.GetSecret(ctx, "app-key")
+		Expect(errors.Is(err, sdsaasv2.ErrSecretNotFound)).To(BeTrue())
+
+		Expect(store.PutSecret(ctx, "app-key", []byte("first"))).To(Succeed())
+		Expect(store.PutSecret(ctx, "app-key", []byte("second"))).To(Succeed())
+		Expect(store.PutSecret(ctx, "other-key", []byte("other"))).To(Succeed())
+		Expect(store.GetSecret(ctx, "app-key")).To(Equal([]byte("second")))
+
+		Expect(store.DeleteSecret(ctx, "app-key")).To(Succeed())
+		Expect(store.DeleteSecret(ctx, "app-key")).To(Succeed())
+		_, err = store.GetSecret(ctx, "app-key")
+		Expect(errors.Is(err, sdsaasv2.ErrSecretNotFound)).To(BeTrue())
+		Expect(store.GetSecret(ctx, "other-key")).To(Equal([]byte("other")))
+	}
+
+	It(`Keeps secrets in memory`, func() {
+		testStore(sdsaasv2.NewMemorySecretStore())
+	})
+	It(`Keeps secrets in environment variables`, func() {
+		store := sdsaasv2.NewEnvSecretStore("sds-test-")
+		Expect(store.VariableName("app-key")).To(Equal("SDS_TEST_APP_KEY"))
+		testStore(store)
+		Expect(os.Getenv("SDS_TEST_OTHER_KEY")).To(Equal("other"))
+		Expect(store.DeleteSecret(ctx, "other-key")).To(Succeed())
+	})
+	It(`Keeps secrets in an encrypted file`, func() {
+		path := filepath.Join(GinkgoT().TempDir(), "secrets", "store")
+		store, err := sdsaasv2.NewFileSecretStore(path, key)
+		Expect(err).To(BeNil())
+		testStore(store)
+
+		data, err := os.ReadFile(path)
+		Expect(err).To(BeNil())
+		Expect(string(data)).ToNot(ContainSubstring("other"))
+		info, err := os.Stat(path)
+		Expect(err).To(BeNil())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+		// The file can be read again with the same key only.
+		store, err = sdsaasv2.NewFileSecretStore(path, key)
+		Expect(err).To(BeNil())
+		Expect(store.GetSecret(ctx, "other-key")).To(Equal([]byte("other")))
+		store, err = sdsaasv2.NewFileSecretStore(path, bytes.Repeat([]byte{8}, sdsaasv2.FileSecretStoreKeySize))
+		Expect(err).To(BeNil())
+		_, err = store.GetSecret(ctx, "other-key")
+		Expect(err).ToNot(BeNil())
+		Expect(errors.Is(err, sdsaasv2.ErrSecretNotFound)).To(BeFalse())
+
+		_, err = sdsaasv2.NewFileSecretStore(path, []byte("short"))
+		Expect(err).ToNot(BeNil())
+	})
+
+	Describe(`CreateHmacCredentialsInStore`, func() {
+		var (
+			testServer    *httptest.Server
+			sdsaasService *sdsaasv2.SdsaasV2
+			deleted       []string
+		)
+		BeforeEach(func() {
+			deleted = nil
+			testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+				defer GinkgoRecover()
+
+				switch req.Method {
+				case "POST":
+					Expect(req.URL.Path).To(Equal("/s3_credentials/app-key"))
+					res.Header().Set("Content-type", "application/json")
+					res.WriteHeader(201)
+					fmt.Fprint(res, `{"access_key": "app-key", "secret_key": "app-secret"}`)
+				case "DELETE":
+					deleted = append(deleted, req.URL.Path)
+					res.WriteHeader(204)
+				default:
+					Fail("unexpected request " + req.Method + " " + req.URL.Path)
+				}
+			}))
+			var serviceErr error
+			sdsaasService, serviceErr = sdsaasv2.NewSdsaasV2(&sdsaasv2.SdsaasV2Options{
+				URL:           testServer.URL,
+				Authenticator: &core.NoAuthAuthenticator{},
+			})
+			Expect(serviceErr).To(BeNil())
+		})
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It(`Writes the secret key to the store`, func() {
+			store := sdsaasv2.NewMemorySecretStore()
+			result, response, err := sdsaasService.CreateHmacCredentialsInStore(sdsaasService.NewCreateHmacCredentialsOptions("app-key"), store)
+			Expect(err).To(BeNil())
+			Expect(response.StatusCode).To(Equal(201))
+			Expect(response.Result).To(Equal(result))
+			Expect(*result.AccessKey).To(Equal("app-key"))
+			Expect(result.SecretKey.Name).To(Equal("app-key"))
+			Expect(result.SecretKey.Secret(ctx)).To(Equal([]byte("app-secret")))
+		})
+		It(`Deletes the credential when the secret key cannot be stored`, func() {
+			_, _, err := sdsaasService.CreateHmacCredentialsInStore(sdsaasService.NewCreateHmacCredentialsOptions("app-key"), &failingSecretStore{})
+			Expect(err).ToNot(BeNil())
+			Expect(err.Error()).To(ContainSubstring("could not be stored"))
+			Expect(deleted).To(Equal([]string{"/s3_credentials/app-key"}))
+
+			_, _, err = sdsaasService.CreateHmacCredentialsInStore(sdsaasService.NewCreateHmacCredentialsOptions("app-key"), nil)
+			Expect(err).ToNot(BeNil())
+		})
+		It(`Stores rotated credentials`, func() {
+			store := sdsaasv2.NewMemorySecretStore()
+			sink := sdsaasv2.NewSecretStoreSink(store)
+			Expect(sink(ctx, &sdsaasv2.AccessKeyResponse{AccessKey: core.StringPtr("app-key"), SecretKey: core.StringPtr("app-secret")})).To(Succeed())
+			Expect(store.GetSecret(ctx, "app-key")).To(Equal([]byte("app-secret")))
+			Expect(sink(ctx, &sdsaasv2.AccessKeyResponse{AccessKey: core.StringPtr("app-key")})).ToNot(Succeed())
+		})
+	})
+})
@@ -0,0 +1,325 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+)
+
+// Constants for the PEM block types accepted by the CreateSslCert and ReplaceSslCert operations.
+const (
+	PemBlockTypeCertificateConst   = "CERTIFICATE"
+	PemBlockTypePrivateKeyConst    = "PRIVATE KEY"
+	PemBlockTypeRsaPrivateKeyConst = "RSA PRIVATE KEY"
+	PemBlockTypeEcPrivateKeyConst  = "EC PRIVATE KEY"
+)
+
+// SslCertificateBundle : A TLS certificate, its intermediate chain and its private key, validated locally before they
+// are uploaded with CreateSslCert or ReplaceSslCert.
+type SslCertificateBundle struct {
+	// The PEM encoded leaf certificate. Any further certificates in this value are treated as the start of the chain.
+	Certificate []byte
+
+	// The PEM encoded intermediate certificates, ordered from the issuer of the leaf towards the root.
+	Chain []byte
+
+	// The PEM encoded private key of the leaf certificate.
+	PrivateKey []byte
+
+	// When set, the leaf certificate must be valid for this S3 endpoint hostname.
+	Hostname *string
+
+	// Returns the time used to check the validity window; defaults to time.Now.
+	Now func() time.Time
+}
+
+// NewSslCertificateBundle : Instantiate SslCertificateBundle from PEM encoded values.
+func NewSslCertificateBundle(certificate []byte, chain []byte, privateKey []byte) *SslCertificateBundle {
+	return &SslCertificateBundle{
+		Certificate: certificate,
+		Chain:       chain,
+		PrivateKey:  privateKey,
+	}
+}
+
+// NewSslCertificateBundleFromFiles : Instantiate SslCertificateBundle from PEM files. The chain file is optional and
+// may be passed as an empty string.
+func NewSslCertificateBundleFromFiles(certificateFile string, chainFile string, privateKeyFile string) (bundle *SslCertificateBundle, err error) {
+	bundle = &SslCertificateBundle{}
+	bundle.Certificate, err = os.ReadFile(certificateFile)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-certificate-error", common.GetComponentInfo())
+		return nil, err
+	}
+	if chainFile != "" {
+		bundle.Chain, err = os.ReadFile(chainFile)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "read-chain-error", common.GetComponentInfo())
+			return nil, err
+		}
+	}
+	bundle.PrivateKey, err = os.ReadFile(privateKeyFile)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "read-private-key-error", common.GetComponentInfo())
+		return nil, err
+	}
+	return
+}
+
+// NewSslCertificateBundleFromTLS : Instantiate SslCertificateBundle from a tls.Certificate. The first certificate
+// is the leaf and any others form the chain.
+func NewSslCertificateBundleFromTLS(certificate tls.Certificate) (bundle *SslCertificateBundle, err error) {
+	if len(certificate.Certificate) == 0 {
+		err = core.SDKErrorf(nil, "the TLS certificate does not contain any certificates", "no-certificate", common.GetComponentInfo())
+		return
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(certificate.PrivateKey)
+	if err != nil {
+		err = core.SDKErrorf(err, "", "marshal-private-key-error", common.GetComponentInfo())
+		return
+	}
+
+	bundle = &SslCertificateBundle{
+		Certificate: pem.EncodeToMemory(&pem.Block{Type: PemBlockTypeCertificateConst, Bytes: certificate.Certificate[0]}),
+		PrivateKey:  pem.EncodeToMemory(&pem.Block{Type: PemBlockTypePrivateKeyConst, Bytes: keyDER}),
+	}
+	for _, der := range certificate.Certificate[1:] {
+		bundle.Chain = append(bundle.Chain, pem.EncodeToMemory(&pem.Block{Type: PemBlockTypeCertificateConst, Bytes: der})...)
+	}
+	return
+}
+
+// SetHostname : Allow user to set Hostname
+func (bundle *SslCertificateBundle) SetHostname(hostname string) *SslCertificateBundle {
+	bundle.Hostname = core.StringPtr(hostname)
+	return bundle
+}
+
+// Validate checks that the private key matches the leaf certificate, that each chain certificate issued the one before
+// it, that every certificate is within its validity window and that the leaf covers Hostname (if set).
+func (bundle *SslCertificateBundle) Validate() (err error) {
+	_, _, _, err = bundle.parse()
+	return
+}
+
+// Leaf returns the parsed leaf certificate after validating the bundle.
+func (bundle *SslCertificateBundle) Leaf() (leaf *x509.Certificate, err error) {
+	certificates, _, _, err := bundle.parse()
+	if err != nil {
+		return
+	}
+	leaf = certificates[0]
+	return
+}
+
+// PEM validates the bundle and assembles the request body in the order the service expects: the leaf certificate,
+// then the chain from the leaf's issuer towards the root, then the private key.
+func (bundle *SslCertificateBundle) PEM() (body []byte, err error) {
+	_, certificateBlocks, keyBlock, err := bundle.parse()
+	if err != nil {
+		return
+	}
+
+	var buffer bytes.Buffer
+	for _, block := range certificateBlocks {
+		_ = pem.Encode(&buffer, block)
+	}
+	_ = pem.Encode(&buffer, keyBlock)
+	body = buffer.Bytes()
+	return
+}
+
+// Body validates the bundle and returns the assembled PEM as a request body.
+func (bundle *SslCertificateBundle) Body() (body io.ReadCloser, err error) {
+	data, err := bundle.PEM()
+	if err != nil {
+		return
+	}
+	body = io.NopCloser(bytes.NewReader(data))
+	return
+}
+
+// NewCreateSslCertOptionsFromBundle : Instantiate CreateSslCertOptions with a body assembled from a validated
+// certificate bundle.
+func (sdsaas *SdsaasV2) NewCreateSslCertOptionsFromBundle(certType string, bundle *SslCertificateBundle) (_options *CreateSslCertOptions, err error) {
+	body, err := bundleBody(bundle)
+	if err != nil {
+		return
+	}
+	_options = sdsaas.NewCreateSslCertOptions(certType).SetBody(body)
+	return
+}
+
+// NewReplaceSslCertOptionsFromBundle : Instantiate ReplaceSslCertOptions with a body assembled from a validated
+// certificate bundle.
+func (sdsaas *SdsaasV2) NewReplaceSslCertOptionsFromBundle(certType string, bundle *SslCertificateBundle) (_options *ReplaceSslCertOptions, err error) {
+	body, err := bundleBody(bundle)
+	if err != nil {
+		return
+	}
+	_options = sdsaas.NewReplaceSslCertOptions(certType).SetBody(body)
+	return
+}
+
+func bundleBody(bundle *SslCertificateBundle) (body io.ReadCloser, err error) {
+	err = core.ValidateNotNil(bundle, "bundle cannot be nil")
+	if err != nil {
+		err = core.SDKErrorf(err, "", "unexpected-nil-param", common.GetComponentInfo())
+		return
+	}
+	body, err = bundle.Body()
+	return
+}
+
+// parse decodes and validates the bundle, returning the certificates (leaf first) with their PEM blocks and the
+// private key block.
+func (bundle *SslCertificateBundle) parse() (certificates []*x509.Certificate, certificateBlocks []*pem.Block, keyBlock *pem.Block, err error) {
+	certificateBlocks, err = decodePemBlocks(bundle.Certificate, "certificate")
+	if err == nil && len(bundle.Chain) > 0 {
+		var chainBlocks []*pem.Block
+		chainBlocks, err = decodePemBlocks(bundle.Chain, "chain")
+		certificateBlocks = append(certificateBlocks, chainBlocks...)
+	}
+	if err != nil {
+		return
+	}
+	if len(certificateBlocks) == 0 {
+		err = core.SDKErrorf(nil, "the bundle does not contain a certificate", "no-certificate", common.GetComponentInfo())
+		return
+	}
+	for i, block := range certificateBlocks {
+		if block.Type != PemBlockTypeCertificateConst {
+			errMsg := fmt.Sprintf("certificate %d has unexpected PEM type '%s'", i, block.Type)
+			err = core.SDKErrorf(nil, errMsg, "invalid-certificate-type", common.GetComponentInfo())
+			return
+		}
+		var certificate *x509.Certificate
+		certificate, err = x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			errMsg := fmt.Sprintf("certificate %d could not be parsed: %s", i, err.Error())
+			err = core.SDKErrorf(err, errMsg, "parse-certificate-error", common.GetComponentInfo())
+			return
+		}
+		certificates = append(certificates, certificate)
+	}
+
+	keyBlocks, err := decodePemBlocks(bundle.PrivateKey, "private key")
+	if err != nil {
+		return
+	}
+	if len(keyBlocks) != 1 {
+		errMsg := fmt.Sprintf("expected exactly one private key, found %d", len(keyBlocks))
+		err = core.SDKErrorf(nil, errMsg, "invalid-private-key-count", common.GetComponentInfo())
+		return
+	}
+	keyBlock = keyBlocks[0]
+	privateKey, err := parsePrivateKey(keyBlock)
+	if err != nil {
+		return
+	}
+
+	leaf := certificates[0]
+	signer, ok := privateKey.(crypto.Signer)
+	if !ok {
+		err = core.SDKErrorf(nil, "the private key type is not supported", "unsupported-private-key", common.GetComponentInfo())
+		return
+	}
+	publicKey, ok := signer.Public().(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !publicKey.Equal(leaf.PublicKey) {
+		err = core.SDKErrorf(nil, "the private key does not match the leaf certificate", "key-mismatch", common.GetComponentInfo())
+		return
+	}
+
+	now := time.Now()
+	if bundle.Now != nil {
+		now = bundle.Now()
+	}
+	for i, certificate := range certificates {
+		if now.Before(certificate.NotBefore) || now.After(certificate.NotAfter) {
+			errMsg := fmt.Sprintf("certificate %d ('%s') is only valid from %s to %s", i, certificate.Subject.String(),
+				certificate.NotBefore.Format(time.RFC3339), certificate.NotAfter.Format(time.RFC3339))
+			err = core.SDKErrorf(nil, errMsg, "certificate-not-valid", common.GetComponentInfo())
+			return
+		}
+		if i == 0 {
+			continue
+		}
+		issued := certificates[i-1]
+		err = issued.CheckSignatureFrom(certificate)
+		if err != nil {
+			errMsg := fmt.Sprintf("certificate %d ('%s') was not issued by certificate %d ('%s'); the chain must be ordered from the leaf towards the root",
+				i-1, issued.Subject.String(), i, certificate.Subject.String())
+			err = core.SDKErrorf(err, errMsg, "chain-order-error", common.GetComponentInfo())
+			return
+		}
+	}
+
+	if bundle.Hostname != nil && *bundle.Hostname != "" {
+		err = leaf.VerifyHostname(*bundle.Hostname)
+		if err != nil {
+			err = core.SDKErrorf(err, "", "hostname-mismatch", common.GetComponentInfo())
+			return
+		}
+	}
+	return
+}
+
+func decodePemBlocks(data []byte, what string) (blocks []*pem.Block, err error) {
+	rest := bytes.TrimSpace(data)
+	for len(rest) > 0 {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			errMsg := fmt.Sprintf("the %s is not PEM encoded", what)
+			err = core.SDKErrorf(nil, errMsg, "pem-decode-error", common.GetComponentInfo())
+			return
+		}
+		blocks = append(blocks, block)
+		rest = bytes.TrimSpace(rest)
+	}
+	return
+}
+
+func parsePrivateKey(block *pem.Block) (key crypto.PrivateKey, err error) {
+	switch block.Type {
+	case PemBlockTypePrivateKeyConst:
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case PemBlockTypeRsaPrivateKeyConst:
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case PemBlockTypeEcPrivateKeyConst:
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	default:
+		errMsg := fmt.Sprintf("the private key has unsupported PEM type '%s'", block.Type)
+		err = core.SDKErrorf(nil, errMsg, "invalid-private-key-type", common.GetComponentInfo())
+		return
+	}
+	if err != nil {
+		err = core.SDKErrorf(err, "", "parse-private-key-error", common.GetComponentInfo())
+	}
+	return
+}
@@ -0,0 +1,91 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"net/http"
+	"slices"
+)
+
+// The positions of the transport layers in the chain, outermost first.
+const (
+	transportLayerDryRun = iota
+	transportLayerAudit
+	transportLayerRetry
+	transportLayerLimiter
+)
+
+// transportLayer : A RoundTripper the service wraps around the transport of its HTTP client.
+type transportLayer interface {
+	http.RoundTripper
+
+	// position returns the place of the layer in the chain; layers with a lower position wrap the others.
+	position() int
+
+	// unwrap returns the transport the layer sends its requests to.
+	unwrap() http.RoundTripper
+
+	// withNext returns a copy of the layer that sends its requests to the transport.
+	withNext(next http.RoundTripper) transportLayer
+}
+
+// getTransportLayer returns the layer of the service at a position, or nil.
+func (sdsaas *SdsaasV2) getTransportLayer(position int) transportLayer {
+	transport := sdsaas.Service.GetHTTPClient().Transport
+	for {
+		layer, ok := transport.(transportLayer)
+		if !ok {
+			return nil
+		}
+		if layer.position() == position {
+			return layer
+		}
+		transport = layer.unwrap()
+	}
+}
+
+// setTransportLayer replaces the layer of the service at a position, or removes it when the layer is nil.
+func (sdsaas *SdsaasV2) setTransportLayer(position int, layer transportLayer) {
+	// The client is copied, since clones of this service share it.
+	client := *sdsaas.Service.GetHTTPClient()
+
+	var layers []transportLayer
+	transport := client.Transport
+	for {
+		current, ok := transport.(transportLayer)
+		if !ok {
+			break
+		}
+		if current.position() != position {
+			layers = append(layers, current)
+		}
+		transport = current.unwrap()
+	}
+	if layer != nil {
+		layers = append(layers, layer)
+	}
+	if transport == nil && len(layers) > 0 {
+		transport = http.DefaultTransport
+	}
+
+	slices.SortFunc(layers, func(a, b transportLayer) int { return a.position() - b.position() })
+	for i := len(layers) - 1; i >= 0; i-- {
+		transport = layers[i].withNext(transport)
+	}
+	client.Transport = transport
+	sdsaas.Service.SetHTTPClient(&client)
+}
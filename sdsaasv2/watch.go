@@ -0,0 +1,339 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"math/rand/v2"
+	"reflect"
+	"slices"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	common "github.com/IBM/sds-go-sdk/v2/common"
+)
+
+// DefaultWatchInterval is the time between polls when WatchOptions.Interval is not set.
+const DefaultWatchInterval = 30 * time.Second
+
+// DefaultWatchBufferSize is the capacity of the event channel when WatchOptions.BufferSize is not set.
+const DefaultWatchBufferSize = 100
+
+// Constants associated with the WatchEvent.Type property.
+// The kind of change reported by a watch.
+const (
+	WatchEventTypeAddedConst    = "added"
+	WatchEventTypeDeletedConst  = "deleted"
+	WatchEventTypeErrorConst    = "error"
+	WatchEventTypeModifiedConst = "modified"
+)
+
+// Constants associated with the WatchEvent.Resource property and WatchOptions.Resources.
+// The kind of resource watched.
+const (
+	WatchResourceHostConst          = "host"
+	WatchResourceSnapshotConst      = "snapshot"
+	WatchResourceVolumeConst        = "volume"
+	WatchResourceVolumeMappingConst = "volume_mapping"
+)
+
+// WatchEvent : A change of a resource observed by a watch.
+type WatchEvent struct {
+	// The kind of change.
+	Type string
+
+	// The kind of resource.
+	Resource string
+
+	// The ID of the resource.
+	ID string
+
+	// The resource: a *Volume, *Host, *VolumeMapping or *Snapshot. For a deleted resource, its last known state.
+	Object interface{}
+
+	// The previous state of a modified resource.
+	OldObject interface{}
+
+	// When set to true, the modified event was emitted by a resync and the resource has not changed.
+	Resync bool
+
+	// The error of an error event: the resources could not be listed.
+	Error error
+
+	// The time of the event.
+	Time time.Time
+}
+
+// Volume returns the volume of the event, or nil for another kind of resource.
+func (event *WatchEvent) Volume() *Volume {
+	volume, _ := event.Object.(*Volume)
+	return volume
+}
+
+// Host returns the host of the event, or nil for another kind of resource.
+func (event *WatchEvent) Host() *Host {
+	host, _ := event.Object.(*Host)
+	return host
+}
+
+// VolumeMapping returns the volume mapping of the event, or nil for another kind of resource.
+func (event *WatchEvent) VolumeMapping() *VolumeMapping {
+	volumeMapping, _ := event.Object.(*VolumeMapping)
+	return volumeMapping
+}
+
+// Snapshot returns the snapshot of the event, or nil for another kind of resource.
+func (event *WatchEvent) Snapshot() *Snapshot {
+	snapshot, _ := event.Object.(*Snapshot)
+	return snapshot
+}
+
+// WatchOptions : Options of a watch.
+type WatchOptions struct {
+	// The kinds of resources watched, e.g. WatchResourceVolumeConst. Every kind is watched when empty. Watching
+	// volume mappings lists the mappings of every host on each poll.
+	Resources []string
+
+	// The time between polls. Defaults to DefaultWatchInterval.
+	Interval time.Duration
+
+	// The largest random delay added to each interval, as a fraction of it, e.g. 0.1. Spreads the polls of many
+	// watchers over time.
+	Jitter float64
+
+	// The time between resyncs, which emit a modified event for every unchanged resource. No resyncs when 0.
+	ResyncInterval time.Duration
+
+	// The capacity of the event channel. Defaults to DefaultWatchBufferSize.
+	BufferSize int
+}
+
+// watcher : The state of a watch.
+type watcher struct {
+	client  *SdsaasV2
+	options WatchOptions
+
+	// Delivers an event, returning false once the watch must stop.
+	emit func(ctx context.Context, event WatchEvent) bool
+
+	// Called after each list of the resources of a kind, once their events have been delivered. Optional.
+	polled func(resource string, err error)
+
+	// The last known resources, by kind and ID.
+	known map[string]map[string]interface{}
+}
+
+// Watch polls the resources of the service and sends their changes on the returned channel until the context is
+// cancelled, then closes it. The first poll reports every existing resource as added. A resource is reported as
+// modified when any of its fields changed since the previous poll. When the resources of a kind cannot be listed,
+// an error event is sent and they are left as they were.
+//
+// Events are sent in the order of the polls, and the watch waits while the channel is full.
+func (sdsaas *SdsaasV2) Watch(ctx context.Context, options *WatchOptions) (events <-chan WatchEvent, err error) {
+	watcher, err := sdsaas.newWatcher(options)
+	if err != nil {
+		return
+	}
+	if watcher.options.BufferSize <= 0 {
+		watcher.options.BufferSize = DefaultWatchBufferSize
+	}
+	channel := make(chan WatchEvent, watcher.options.BufferSize)
+	watcher.emit = func(ctx context.Context, event WatchEvent) bool {
+		select {
+		case channel <- event:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+	go func() {
+		defer close(channel)
+		watcher.run(ctx)
+	}()
+	return channel, nil
+}
+
+// newWatcher returns a watcher with the options, and their defaults, that does not deliver its events yet.
+func (sdsaas *SdsaasV2) newWatcher(options *WatchOptions) (*watcher, error) {
+	watcher := &watcher{client: sdsaas, known: map[string]map[string]interface{}{}}
+	if options != nil {
+		watcher.options = *options
+	}
+	if len(watcher.options.Resources) == 0 {
+		watcher.options.Resources = []string{WatchResourceVolumeConst, WatchResourceHostConst, WatchResourceVolumeMappingConst, WatchResourceSnapshotConst}
+	}
+	for _, resource := range watcher.options.Resources {
+		if !slices.Contains([]string{WatchResourceVolumeConst, WatchResourceHostConst, WatchResourceVolumeMappingConst, WatchResourceSnapshotConst}, resource) {
+			return nil, core.SDKErrorf(nil, fmt.Sprintf("unknown watch resource %q", resource), "unknown-watch-resource", common.GetComponentInfo())
+		}
+	}
+	if watcher.options.Interval <= 0 {
+		watcher.options.Interval = DefaultWatchInterval
+	}
+	return watcher, nil
+}
+
+func (watcher *watcher) run(ctx context.Context) {
+	lastResync := time.Now()
+	for {
+		resync := watcher.options.ResyncInterval > 0 && time.Since(lastResync) >= watcher.options.ResyncInterval
+		if resync {
+			lastResync = time.Now()
+		}
+		if !watcher.poll(ctx, resync) {
+			return
+		}
+
+		wait := watcher.options.Interval
+		if watcher.options.Jitter > 0 {
+			wait += time.Duration(rand.Float64() * watcher.options.Jitter * float64(wait))
+		}
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+	}
+}
+
+// poll lists every kind of resource and sends the changes, returning false once the context is cancelled.
+func (watcher *watcher) poll(ctx context.Context, resync bool) bool {
+	for _, resource := range watcher.options.Resources {
+		objects, err := watcher.list(ctx, resource)
+		if ctx.Err() != nil {
+			return false
+		}
+		if err != nil {
+			if !watcher.send(ctx, WatchEvent{Type: WatchEventTypeErrorConst, Resource: resource, Error: err}) {
+				return false
+			}
+			watcher.notifyPolled(resource, err)
+			continue
+		}
+
+		known := watcher.known[resource]
+		for _, id := range slices.Sorted(maps.Keys(objects)) {
+			event := WatchEvent{Resource: resource, ID: id, Object: objects[id]}
+			old, exists := known[id]
+			switch {
+			case !exists:
+				event.Type = WatchEventTypeAddedConst
+			case !reflect.DeepEqual(old, objects[id]):
+				event.Type, event.OldObject = WatchEventTypeModifiedConst, old
+			case resync:
+				event.Type, event.OldObject, event.Resync = WatchEventTypeModifiedConst, old, true
+			default:
+				continue
+			}
+			if !watcher.send(ctx, event) {
+				return false
+			}
+		}
+		for _, id := range slices.Sorted(maps.Keys(known)) {
+			if _, exists := objects[id]; !exists {
+				if !watcher.send(ctx, WatchEvent{Type: WatchEventTypeDeletedConst, Resource: resource, ID: id, Object: known[id]}) {
+					return false
+				}
+			}
+		}
+		watcher.known[resource] = objects
+		watcher.notifyPolled(resource, nil)
+	}
+	return true
+}
+
+func (watcher *watcher) notifyPolled(resource string, err error) {
+	if watcher.polled != nil {
+		watcher.polled(resource, err)
+	}
+}
+
+func (watcher *watcher) send(ctx context.Context, event WatchEvent) bool {
+	event.Time = time.Now()
+	return watcher.emit(ctx, event)
+}
+
+// list returns the resources of a kind by ID.
+func (watcher *watcher) list(ctx context.Context, resource string) (objects map[string]interface{}, err error) {
+	client := watcher.client
+	objects = map[string]interface{}{}
+	switch resource {
+	case WatchResourceVolumeConst:
+		var pager *VolumesPager
+		var items []Volume
+		pager, err = client.NewVolumesPager(&ListVolumesOptions{})
+		if err == nil {
+			items, err = pager.GetAllWithContext(ctx)
+		}
+		for i := range items {
+			objects[core.StringNilMapper(items[i].ID)] = &items[i]
+		}
+	case WatchResourceHostConst:
+		var items []Host
+		items, err = watcher.listHosts(ctx)
+		for i := range items {
+			objects[core.StringNilMapper(items[i].ID)] = &items[i]
+		}
+	case WatchResourceVolumeMappingConst:
+		var hosts []Host
+		hosts, err = watcher.listHosts(ctx)
+		for _, host := range hosts {
+			var pager *VolumeMappingsPager
+			var items []VolumeMapping
+			pager, err = client.NewVolumeMappingsPager(client.NewListVolumeMappingsOptions(core.StringNilMapper(host.ID)))
+			if err == nil {
+				items, err = pager.GetAllWithContext(ctx)
+			}
+			if err != nil {
+				break
+			}
+			for i := range items {
+				// The host is implied by the list, so it is filled in when the service leaves it out.
+				if items[i].Host == nil {
+					items[i].Host = &HostReference{ID: host.ID, Name: host.Name, Nqn: host.Nqn}
+				}
+				objects[core.StringNilMapper(items[i].ID)] = &items[i]
+			}
+		}
+	case WatchResourceSnapshotConst:
+		var pager *SnapshotsPager
+		var items []Snapshot
+		pager, err = client.NewSnapshotsPager(&ListSnapshotsOptions{})
+		if err == nil {
+			items, err = pager.GetAllWithContext(ctx)
+		}
+		for i := range items {
+			objects[core.StringNilMapper(items[i].ID)] = &items[i]
+		}
+	}
+	if err != nil {
+		err = core.RepurposeSDKProblem(err, "watch-list-error")
+	}
+	return
+}
+
+func (watcher *watcher) listHosts(ctx context.Context) ([]Host, error) {
+	pager, err := watcher.client.NewHostsPager(&ListHostsOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return pager.GetAllWithContext(ctx)
+}
@@ -0,0 +1,174 @@
+/**
+ * (C) Copyright IBM Corp. 2026.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package sdsaasv2_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/sds-go-sdk/v2/sdsaasv2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe(`Watch`, func() {
+	var (
+		testServer    *httptest.Server
+		sdsaasService *sdsaasv2.SdsaasV2
+		mutex         sync.Mutex
+		volumes       map[string]string
+		snapshotsDown bool
+		ctx           context.Context
+		cancel        context.CancelFunc
+	)
+	BeforeEach(func() {
+		volumes = map[string]string{"v1": "pending", "v2": "available"}
+		snapshotsDown = false
+		testServer = httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			mutex.Lock()
+			defer mutex.Unlock()
+			res.Header().Set("Content-type", "application/json")
+			switch req.URL.Path {
+			case "/volumes":
+				var items []string
+				for _, id := range []string{"v1", "v2", "v3"} {
+					if status, ok := volumes[id]; ok {
+						items = append(items, fmt.Sprintf(`{"id": "%s", "status": "%s"}`, id, status))
+					}
+				}
+				res.WriteHeader(200)
+				fmt.Fprintf(res, `{"volumes": [%s]}`, strings.Join(items, ","))
+			case "/hosts":
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"hosts": [{"id": "h1", "name": "node-1"}]}`)
+			case "/hosts/h1/volume_mappings":
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"volume_mappings": [{"id": "m1", "status": "mapped"}]}`)
+			case "/snapshots":
+				if snapshotsDown {
+					res.WriteHeader(500)
+					fmt.Fprint(res, `{"errors": [{"code": "internal_error", "message": "down"}]}`)
+					return
+				}
+				res.WriteHeader(200)
+				fmt.Fprint(res, `{"snapshots": []}`)
+			}
+		}))
+		var err error
+		sdsaasService, err = sdsaasv2.NewSdsaasV2(&sdsaasv2.SdsaasV2Options{
+			URL:           testServer.URL,
+			Authenticator: &core.NoAuthAuthenticator{},
+		})
+		Expect(err).To(BeNil())
+		ctx, cancel = context.WithCancel(context.Background())
+	})
+	AfterEach(func() {
+		cancel()
+		testServer.Close()
+	})
+
+	receive := func(events <-chan sdsaasv2.WatchEvent) sdsaasv2.WatchEvent {
+		var event sdsaasv2.WatchEvent
+		Eventually(events).Should(Receive(&event))
+		return event
+	}
+	setVolumes := func(update func()) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		update()
+	}
+
+	It(`Emits added, modified and deleted events`, func() {
+		events, err := sdsaasService.Watch(ctx, &sdsaasv2.WatchOptions{
+			Resources: []string{sdsaasv2.WatchResourceVolumeConst, sdsaasv2.WatchResourceVolumeMappingConst},
+			Interval:  10 * time.Millisecond,
+			Jitter:    0.5,
+		})
+		Expect(err).To(BeNil())
+
+		event := receive(events)
+		Expect(event.Type).To(Equal(sdsaasv2.WatchEventTypeAddedConst))
+		Expect(event.Resource).To(Equal(sdsaasv2.WatchResourceVolumeConst))
+		Expect(event.ID).To(Equal("v1"))
+		Expect(*event.Volume().Status).To(Equal(sdsaasv2.VolumeStatusPendingConst))
+		Expect(receive(events).ID).To(Equal("v2"))
+		event = receive(events)
+		Expect(event.Resource).To(Equal(sdsaasv2.WatchResourceVolumeMappingConst))
+		Expect(*event.VolumeMapping().Status).To(Equal(sdsaasv2.VolumeMappingStatusMappedConst))
+		Expect(event.Host()).To(BeNil())
+
+		// Unchanged resources are not reported again.
+		Consistently(events, 50*time.Millisecond).ShouldNot(Receive())
+
+		setVolumes(func() {
+			volumes["v1"] = "available"
+			delete(volumes, "v2")
+			volumes["v3"] = "pending"
+		})
+		event = receive(events)
+		Expect(event.Type).To(Equal(sdsaasv2.WatchEventTypeModifiedConst))
+		Expect(*event.Volume().Status).To(Equal(sdsaasv2.VolumeStatusAvailableConst))
+		Expect(*event.OldObject.(*sdsaasv2.Volume).Status).To(Equal(sdsaasv2.VolumeStatusPendingConst))
+		Expect(event.Resync).To(BeFalse())
+		event = receive(events)
+		Expect(event.Type).To(Equal(sdsaasv2.WatchEventTypeAddedConst))
+		Expect(event.ID).To(Equal("v3"))
+		event = receive(events)
+		Expect(event.Type).To(Equal(sdsaasv2.WatchEventTypeDeletedConst))
+		Expect(event.ID).To(Equal("v2"))
+		Expect(*event.Volume().Status).To(Equal(sdsaasv2.VolumeStatusAvailableConst))
+
+		cancel()
+		Eventually(events).Should(BeClosed())
+	})
+	It(`Reports list errors and resyncs`, func() {
+		setVolumes(func() { snapshotsDown = true })
+		events, err := sdsaasService.Watch(ctx, &sdsaasv2.WatchOptions{
+			Resources:      []string{sdsaasv2.WatchResourceHostConst, sdsaasv2.WatchResourceSnapshotConst},
+			Interval:       10 * time.Millisecond,
+			ResyncInterval: 30 * time.Millisecond,
+		})
+		Expect(err).To(BeNil())
+
+		Expect(receive(events).Type).To(Equal(sdsaasv2.WatchEventTypeAddedConst))
+		event := receive(events)
+		Expect(event.Type).To(Equal(sdsaasv2.WatchEventTypeErrorConst))
+		Expect(event.Resource).To(Equal(sdsaasv2.WatchResourceSnapshotConst))
+		Expect(event.Error).ToNot(BeNil())
+
+		setVolumes(func() { snapshotsDown = false })
+		for {
+			event = receive(events)
+			if event.Type != sdsaasv2.WatchEventTypeErrorConst {
+				break
+			}
+		}
+		Expect(event.Type).To(Equal(sdsaasv2.WatchEventTypeModifiedConst))
+		Expect(event.Resync).To(BeTrue())
+		Expect(*event.Host().Name).To(Equal("node-1"))
+	})
+	It(`Rejects unknown resources`, func() {
+		_, err := sdsaasService.Watch(ctx, &sdsaasv2.WatchOptions{Resources: []string{"bucket"}})
+		Expect(err).ToNot(BeNil())
+	})
+})